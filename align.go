@@ -0,0 +1,72 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "math/bits"
+
+// CeilPow2 returns the least integer power of 2, which is not less than `value`, and `true`.
+// Values less than 1 produce 1. If the power does not fit into T, second result is `false`.
+func CeilPow2[T Integer](value T) (T, bool) {
+	if value <= 1 {
+		return 1, true
+	}
+	power := T(1) << bits.Len64(uint64(value - 1))
+	return power, power > 0
+}
+
+// FloorPow2 returns the greatest integer power of 2, which is not greater than `value`, and `true`.
+// Zero and negative values produce `false`.
+func FloorPow2[T Integer](value T) (T, bool) {
+	if value <= 0 {
+		return 0, false
+	}
+	return T(1) << (bits.Len64(uint64(value)) - 1), true
+}
+
+// AlignUp returns the least multiple of `alignment`, which is not less than `value`, and `true`.
+// If the result does not fit into T or `alignment` is not positive, second result is `false`.
+func AlignUp[T Integer](value, alignment T) (T, bool) {
+	if alignment <= 0 {
+		return 0, false
+	}
+	if remainder := modPositive(value, alignment); remainder != 0 {
+		return addChecked(value, alignment - remainder)
+	}
+	return value, true
+}
+
+// AlignDown returns the greatest multiple of `alignment`, which is not greater than `value`, and `true`.
+// If the result does not fit into T or `alignment` is not positive, second result is `false`.
+func AlignDown[T Integer](value, alignment T) (T, bool) {
+	if alignment <= 0 {
+		return 0, false
+	}
+	return subChecked(value, modPositive(value, alignment))
+}
+
+// IsAligned checks whether `value` is a multiple of `alignment`. Non-positive `alignment` always produces `false`.
+func IsAligned[T Integer](value, alignment T) bool {
+	return alignment > 0 && value % alignment == 0
+}
+
+// modPositive returns `value` modulo positive `modulus` in [0, modulus) range.
+func modPositive[T Integer](value, modulus T) T {
+	remainder := value % modulus
+	if remainder < 0 {
+		remainder += modulus
+	}
+	return remainder
+}
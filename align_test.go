@@ -0,0 +1,108 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "testing"
+
+func TestPow2(t *testing.T) {
+	if power, ok := CeilPow2(uint32(1000)); !ok || power != 1024 {
+		t.Errorf("CeilPow2(1000) == %d, %v", power, ok)
+	}
+	if power, ok := CeilPow2(int8(64)); !ok || power != 64 {
+		t.Errorf("CeilPow2(64) == %d, %v", power, ok)
+	}
+	for _, value := range []int8{65, 100, 127} {
+		if _, ok := CeilPow2(value); ok {
+			t.Errorf("CeilPow2(int8(%d)) fits", value)
+		}
+	}
+	if power, ok := CeilPow2(int8(-128)); !ok || power != 1 {
+		t.Errorf("CeilPow2(-128) == %d, %v", power, ok)
+	}
+	if power, ok := CeilPow2(uint8(128)); !ok || power != 128 {
+		t.Errorf("CeilPow2(uint8(128)) == %d, %v", power, ok)
+	}
+	if _, ok := CeilPow2(uint8(129)); ok {
+		t.Error("CeilPow2(uint8(129)) fits")
+	}
+	if power, ok := CeilPow2(uint64(1 << 63)); !ok || power != 1 << 63 {
+		t.Errorf("CeilPow2(2 ^ 63) == %d, %v", power, ok)
+	}
+	if _, ok := CeilPow2(uint64(1 << 63 + 1)); ok {
+		t.Error("CeilPow2(2 ^ 63 + 1) fits")
+	}
+	if _, ok := CeilPow2(int64(1 << 62 + 1)); ok {
+		t.Error("CeilPow2(2 ^ 62 + 1) fits")
+	}
+	if power, ok := FloorPow2(1000); !ok || power != 512 {
+		t.Errorf("FloorPow2(1000) == %d, %v", power, ok)
+	}
+	if power, ok := FloorPow2(uint64(1 << 64 - 1)); !ok || power != 1 << 63 {
+		t.Errorf("FloorPow2(Maximal) == %d, %v", power, ok)
+	}
+	if power, ok := FloorPow2(int64(1 << 63 - 1)); !ok || power != 1 << 62 {
+		t.Errorf("FloorPow2(Maximal) == %d, %v", power, ok)
+	}
+	for _, value := range []int8{0, -1, -128} {
+		if _, ok := FloorPow2(value); ok {
+			t.Errorf("FloorPow2(%d) exists", value)
+		}
+	}
+}
+
+func TestAlign(t *testing.T) {
+	tests := []struct {
+		value, alignment, up, down int8
+		upOK, downOK bool
+	}{
+		{13, 8, 16, 8, true, true},
+		{-5, 4, -4, -8, true, true},
+		{127, 2, 0, 126, false, true},
+		{120, 127, 127, 0, true, true},
+		{127, 127, 127, 127, true, true},
+		{-128, 3, -126, 0, true, false},
+		{-127, 2, -126, -128, true, true},
+		{-128, 127, -127, 0, true, false},
+		{5, 0, 0, 0, false, false},
+		{5, -4, 0, 0, false, false},
+	}
+	for _, test := range tests {
+		if up, ok := AlignUp(test.value, test.alignment); ok != test.upOK || ok && up != test.up {
+			t.Errorf("AlignUp(%d, %d) == %d, %v", test.value, test.alignment, up, ok)
+		}
+		if down, ok := AlignDown(test.value, test.alignment); ok != test.downOK || ok && down != test.down {
+			t.Errorf("AlignDown(%d, %d) == %d, %v", test.value, test.alignment, down, ok)
+		}
+	}
+	if up, ok := AlignUp(uint(13), 8); !ok || up != 16 {
+		t.Errorf("AlignUp(13, 8) == %d, %v", up, ok)
+	}
+	if _, ok := AlignUp(uint8(250), 16); ok {
+		t.Error("AlignUp(250, 16) fits")
+	}
+	if up, ok := AlignUp(uint8(240), 16); !ok || up != 240 {
+		t.Errorf("AlignUp(240, 16) == %d, %v", up, ok)
+	}
+	if down, ok := AlignDown(uint64(1 << 64 - 1), 1 << 32); !ok || down != 1 << 64 - 1 << 32 {
+		t.Errorf("AlignDown(Maximal, 2 ^ 32) == %d, %v", down, ok)
+	}
+	if !IsAligned(int64(-24), 8) || IsAligned(int64(-20), 8) || !IsAligned(int64(-1 << 63), 2) {
+		t.Error("IsAligned(-24, 8), IsAligned(-20, 8) or IsAligned(Minimal, 2) is wrong")
+	}
+	if IsAligned(int64(-1 << 63), -1) || IsAligned(5, 0) { // Non-positive alignment must not be divided by
+		t.Error("IsAligned with non-positive alignment is true")
+	}
+}
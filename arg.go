@@ -0,0 +1,119 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+// Arg* functions return indexes of minimal and maximal slice items instead of the items themselves.
+// Ties are resolved to the first occurrence, or to the last one for *Last functions.
+// Empty slice produces -1 indexes (or nil for *All functions).
+
+func ArgMinSlice[T Integer](values []T) int {
+	index := -1
+	for i, v := range values {
+		if index < 0 || v < values[index] {
+			index = i
+		}
+	}
+	return index
+}
+
+func ArgMinSliceLast[T Integer](values []T) int {
+	index := -1
+	for i, v := range values {
+		if index < 0 || v <= values[index] {
+			index = i
+		}
+	}
+	return index
+}
+
+// ArgMinSliceAll returns indexes of all occurrences of minimal item in ascending order.
+func ArgMinSliceAll[T Integer](values []T) []int {
+	if len(values) == 0 {
+		return nil
+	}
+	return indexes(values, MinSlice(values))
+}
+
+func ArgMaxSlice[T Integer](values []T) int {
+	index := -1
+	for i, v := range values {
+		if index < 0 || v > values[index] {
+			index = i
+		}
+	}
+	return index
+}
+
+func ArgMaxSliceLast[T Integer](values []T) int {
+	index := -1
+	for i, v := range values {
+		if index < 0 || v >= values[index] {
+			index = i
+		}
+	}
+	return index
+}
+
+// ArgMaxSliceAll returns indexes of all occurrences of maximal item in ascending order.
+func ArgMaxSliceAll[T Integer](values []T) []int {
+	if len(values) == 0 {
+		return nil
+	}
+	return indexes(values, MaxSlice(values))
+}
+
+// ArgMinMaxSlice returns indexes of minimal (first) and maximal (second) items in a single pass.
+func ArgMinMaxSlice[T Integer](values []T) (int, int) {
+	if len(values) == 0 {
+		return -1, -1
+	}
+	min, max := 0, 0
+	for i, v := range values[1:] {
+		if v < values[min] {
+			min = i + 1
+		} else if v > values[max] {
+			max = i + 1
+		}
+	}
+	return min, max
+}
+
+func ArgMinMaxSliceLast[T Integer](values []T) (int, int) {
+	if len(values) == 0 {
+		return -1, -1
+	}
+	min, max := 0, 0
+	for i, v := range values[1:] {
+		if v <= values[min] {
+			min = i + 1
+		}
+		if v >= values[max] {
+			max = i + 1
+		}
+	}
+	return min, max
+}
+
+// indexes returns indexes of all occurrences of `value` in `values`.
+func indexes[T Integer](values []T, value T) []int {
+	var result []int
+	for i, v := range values {
+		if v == value {
+			result = append(result, i)
+		}
+	}
+	return result
+}
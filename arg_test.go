@@ -0,0 +1,71 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestArg(t *testing.T) {
+	tests := []struct {
+		values []int64
+		min, minLast, max, maxLast int
+		minAll, maxAll []int
+	}{
+		{[]int64{3, 1, 4, 1}, 1, 3, 2, 2, []int{1, 3}, []int{2}},
+		{[]int64{5, -1, 9}, 1, 1, 2, 2, []int{1}, []int{2}},
+		{[]int64{7, 7, 7}, 0, 2, 0, 2, []int{0, 1, 2}, []int{0, 1, 2}},
+		{[]int64{1 << 63 - 1, -1 << 63, 1 << 63 - 1, -1 << 63}, 1, 3, 0, 2, []int{1, 3}, []int{0, 2}},
+		{[]int64{-1 << 63}, 0, 0, 0, 0, []int{0}, []int{0}},
+		{[]int64{}, -1, -1, -1, -1, nil, nil},
+	}
+	for _, test := range tests {
+		if min := ArgMinSlice(test.values); min != test.min {
+			t.Errorf("ArgMinSlice(%v) == %d, want %d", test.values, min, test.min)
+		}
+		if min := ArgMinSliceLast(test.values); min != test.minLast {
+			t.Errorf("ArgMinSliceLast(%v) == %d, want %d", test.values, min, test.minLast)
+		}
+		if max := ArgMaxSlice(test.values); max != test.max {
+			t.Errorf("ArgMaxSlice(%v) == %d, want %d", test.values, max, test.max)
+		}
+		if max := ArgMaxSliceLast(test.values); max != test.maxLast {
+			t.Errorf("ArgMaxSliceLast(%v) == %d, want %d", test.values, max, test.maxLast)
+		}
+		if min, max := ArgMinMaxSlice(test.values); min != test.min || max != test.max {
+			t.Errorf("ArgMinMaxSlice(%v) == %d, %d", test.values, min, max)
+		}
+		if min, max := ArgMinMaxSliceLast(test.values); min != test.minLast || max != test.maxLast {
+			t.Errorf("ArgMinMaxSliceLast(%v) == %d, %d", test.values, min, max)
+		}
+		if all := ArgMinSliceAll(test.values); !slices.Equal(all, test.minAll) {
+			t.Errorf("ArgMinSliceAll(%v) == %v, want %v", test.values, all, test.minAll)
+		}
+		if all := ArgMaxSliceAll(test.values); !slices.Equal(all, test.maxAll) {
+			t.Errorf("ArgMaxSliceAll(%v) == %v, want %v", test.values, all, test.maxAll)
+		}
+	}
+	if all := ArgMaxSliceAll([]uint8{7, 2, 7}); !slices.Equal(all, []int{0, 2}) {
+		t.Errorf("ArgMaxSliceAll(7, 2, 7) == %v", all)
+	}
+	if all := ArgMinSliceAll([]uint64(nil)); all != nil {
+		t.Errorf("ArgMinSliceAll(nil) == %v, want nil", all)
+	}
+	if min, max := ArgMinMaxSlice([]uint8{255, 0, 255, 0}); min != 1 || max != 0 {
+		t.Errorf("ArgMinMaxSlice(255, 0, 255, 0) == %d, %d", min, max)
+	}
+}
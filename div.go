@@ -0,0 +1,140 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+// Division functions with various rounding of quotient.
+// Remainder always satisfies dividend == quotient * divisor + remainder
+// (for unsigned types it wraps around if quotient is rounded up).
+// Zero divisor causes division by zero error.
+// The only overflowing case, Minimal / -1, wraps around to Minimal like built-in division does.
+// *Err functions report these cases as ErrDivisionByZero and ErrOverflow instead.
+
+// DivModFloor returns quotient rounded toward negative infinity and remainder with sign of divisor.
+func DivModFloor[T Integer](dividend, divisor T) (T, T) {
+	quotient, remainder := dividend / divisor, dividend % divisor
+	if remainder != 0 && (remainder < 0) != (divisor < 0) {
+		quotient--
+		remainder += divisor
+	}
+	return quotient, remainder
+}
+
+func DivFloor[T Integer](dividend, divisor T) T {
+	quotient, _ := DivModFloor(dividend, divisor)
+	return quotient
+}
+
+// DivModCeil returns quotient rounded toward positive infinity and remainder with sign opposite to divisor.
+func DivModCeil[T Integer](dividend, divisor T) (T, T) {
+	quotient, remainder := dividend / divisor, dividend % divisor
+	if remainder != 0 && (remainder < 0) == (divisor < 0) {
+		quotient++
+		remainder -= divisor
+	}
+	return quotient, remainder
+}
+
+func DivCeil[T Integer](dividend, divisor T) T {
+	quotient, _ := DivModCeil(dividend, divisor)
+	return quotient
+}
+
+// DivModEuclid returns quotient and remainder of Euclidean division: remainder is always non-negative.
+func DivModEuclid[T Integer](dividend, divisor T) (T, T) {
+	quotient, remainder := dividend / divisor, dividend % divisor
+	if remainder < 0 {
+		if divisor > 0 {
+			quotient--
+			remainder += divisor
+		} else {
+			quotient++
+			remainder -= divisor
+		}
+	}
+	return quotient, remainder
+}
+
+func DivEuclid[T Integer](dividend, divisor T) T {
+	quotient, _ := DivModEuclid(dividend, divisor)
+	return quotient
+}
+
+func ModEuclid[T Integer](dividend, divisor T) T {
+	_, remainder := DivModEuclid(dividend, divisor)
+	return remainder
+}
+
+// DivModRound returns quotient rounded to the nearest integer (halves are rounded away from zero) and remainder.
+func DivModRound[T Integer](dividend, divisor T) (T, T) {
+	return divModRound(dividend, divisor, false)
+}
+
+func DivRound[T Integer](dividend, divisor T) T {
+	quotient, _ := DivModRound(dividend, divisor)
+	return quotient
+}
+
+// DivModRoundEven returns quotient rounded to the nearest integer (halves are rounded to even) and remainder.
+func DivModRoundEven[T Integer](dividend, divisor T) (T, T) {
+	return divModRound(dividend, divisor, true)
+}
+
+func DivRoundEven[T Integer](dividend, divisor T) T {
+	quotient, _ := DivModRoundEven(dividend, divisor)
+	return quotient
+}
+
+// DivModFloorErr returns quotient rounded toward negative infinity and remainder like DivModFloor,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModFloorErr[T Integer](dividend, divisor T) (T, T, error) {
+	return divModErr(dividend, divisor, DivModFloor[T])
+}
+
+// DivModCeilErr returns quotient rounded toward positive infinity and remainder like DivModCeil,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModCeilErr[T Integer](dividend, divisor T) (T, T, error) {
+	return divModErr(dividend, divisor, DivModCeil[T])
+}
+
+// DivModEuclidErr returns quotient of Euclidean division and remainder like DivModEuclid,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModEuclidErr[T Integer](dividend, divisor T) (T, T, error) {
+	return divModErr(dividend, divisor, DivModEuclid[T])
+}
+
+// DivModRoundErr returns quotient rounded to the nearest integer (halves away from zero) and remainder like DivModRound,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModRoundErr[T Integer](dividend, divisor T) (T, T, error) {
+	return divModErr(dividend, divisor, DivModRound[T])
+}
+
+// DivModRoundEvenErr returns quotient rounded to the nearest integer (halves to even) and remainder like DivModRoundEven,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModRoundEvenErr[T Integer](dividend, divisor T) (T, T, error) {
+	return divModErr(dividend, divisor, DivModRoundEven[T])
+}
+
+func divModRound[T Integer](dividend, divisor T, halfEven bool) (T, T) {
+	quotient, remainder := dividend / divisor, dividend % divisor
+	r, d := absu[uint64](remainder), absu[uint64](divisor)
+	if r == 0 || r < d - r || r == d - r && halfEven && !IsOdd(quotient) { // Truncated quotient is the nearest one
+		return quotient, remainder
+	}
+	if (dividend < 0) != (divisor < 0) { // Negative quotient
+		return quotient - 1, remainder + divisor
+	}
+	return quotient + 1, remainder - divisor
+}
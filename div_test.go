@@ -0,0 +1,69 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math"
+	"testing"
+)
+
+// TestDivModModes checks all rounding modes against float64 division for every pair of int8 values.
+func TestDivModModes(t *testing.T) {
+	modes := []struct {
+		name string
+		divMod func(int8, int8) (int8, int8, error)
+		round func(float64) float64
+	}{
+		{"Floor", DivModFloorErr[int8], math.Floor},
+		{"Ceil", DivModCeilErr[int8], math.Ceil},
+		{"Round", DivModRoundErr[int8], math.Round},
+		{"RoundEven", DivModRoundEvenErr[int8], math.RoundToEven},
+	}
+	for dividend := -128; dividend < 128; dividend++ {
+		for divisor := -128; divisor < 128; divisor++ {
+			for _, mode := range modes {
+				quotient, remainder, err := mode.divMod(int8(dividend), int8(divisor))
+				switch {
+				case divisor == 0:
+					if err != ErrDivisionByZero {
+						t.Fatalf("DivMod%sErr(%d, 0) error == %v", mode.name, dividend, err)
+					}
+				case dividend == -128 && divisor == -1:
+					if err != ErrOverflow {
+						t.Fatalf("DivMod%sErr(-128, -1) error == %v", mode.name, err)
+					}
+				default:
+					want := int(mode.round(float64(dividend) / float64(divisor)))
+					if err != nil || int(quotient) != want || int(quotient) * divisor + int(remainder) != dividend {
+						t.Fatalf("DivMod%sErr(%d, %d) == %d, %d, %v, want quotient %d", mode.name, dividend, divisor, quotient, remainder, err, want)
+					}
+				}
+			}
+			if divisor != 0 && !(dividend == -128 && divisor == -1) {
+				quotient, remainder, err := DivModEuclidErr(int8(dividend), int8(divisor))
+				if err != nil || remainder < 0 || int(remainder) >= max(divisor, -divisor) || int(quotient) * divisor + int(remainder) != dividend {
+					t.Fatalf("DivModEuclidErr(%d, %d) == %d, %d, %v", dividend, divisor, quotient, remainder, err)
+				}
+			}
+		}
+	}
+	if _, _, err := DivModEuclidErr(int64(-1 << 63), -1); err != ErrOverflow {
+		t.Errorf("DivModEuclidErr(Minimal, -1) error == %v", err)
+	}
+	if quotient, remainder, err := DivModCeilErr(uint8(255), 2); err != nil || quotient != 128 || remainder != 255 {
+		t.Errorf("DivModCeilErr(255, 2) == %d, %d, %v", quotient, remainder, err)
+	}
+}
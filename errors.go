@@ -0,0 +1,31 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+// Error is the type of sentinel errors returned by *Err functions of this package and its subpackages.
+// Being a constant string type, errors can be compared directly or via `errors.Is`.
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const (
+	ErrDivisionByZero = Error("imath: division by zero")
+	ErrEmpty = Error("imath: empty slice")
+	ErrNegative = Error("imath: negative argument")
+	ErrOverflow = Error("imath: integer overflow")
+)
@@ -0,0 +1,106 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "slices"
+
+// Factor is a prime factor of integer with its multiplicity.
+type Factor[U Unsigned] struct {
+	Prime U
+	Exponent uint
+}
+
+// Factorize returns prime factors of |value| with their exponents in ascending order of primes.
+// Factors have unsigned type U, which must not be narrower than T. 0 and 1 have no prime factors.
+// Primes below 2 ^ 8 are found by trial division, greater ones by Pollard-Brent rho method and Miller-Rabin test.
+func Factorize[U Unsigned, T Integer](value T) (factors []Factor[U]) {
+	v := uint64(absu[U](value))
+	for _, p := range smallPrimes {
+		if p * p > v {
+			break
+		}
+		if v % p == 0 {
+			factor := Factor[U]{Prime: U(p)}
+			for ; v % p == 0; v /= p {
+				factor.Exponent++
+			}
+			factors = append(factors, factor)
+		}
+	}
+	if v <= 1 {
+		return
+	}
+	primes := factorize64(v, nil)
+	slices.Sort(primes)
+	for _, p := range primes {
+		if last := len(factors) - 1; last >= 0 && factors[last].Prime == U(p) {
+			factors[last].Exponent++
+		} else {
+			factors = append(factors, Factor[U]{Prime: U(p), Exponent: 1})
+		}
+	}
+	return
+}
+
+// factorize64 appends prime factors (with repetitions) of `value` without factors below 2 ^ 8 to `primes`.
+func factorize64(value uint64, primes []uint64) []uint64 {
+	if isPrime64(value) {
+		return append(primes, value)
+	}
+	divisor := rho64(value)
+	return factorize64(value / divisor, factorize64(divisor, primes))
+}
+
+// rho64 returns nontrivial divisor of odd composite `value` using Pollard's rho method with Brent's cycle detection.
+// Differences are accumulated in a product to take GCD once per batch, and batch is replayed if it skipped the divisor.
+func rho64(value uint64) uint64 {
+	const batch = 128
+	for c := uint64(1); ; c++ { // Pseudorandom sequence x -> x ^ 2 + c (mod value); next c is tried on failure
+		next := func(x uint64) uint64 {
+			x = mulMod64(x, x, value)
+			if x >= value - c {
+				return x - (value - c)
+			}
+			return x + c
+		}
+		y, divisor := uint64(2), uint64(1)
+		var x, saved uint64
+		for length := uint64(1); divisor == 1; length *= 2 {
+			x = y
+			for range length {
+				y = next(y)
+			}
+			for k := uint64(0); k < length && divisor == 1; k += batch {
+				saved = y
+				product := uint64(1)
+				for range min(batch, length - k) {
+					y = next(y)
+					product = mulMod64(product, max(x, y) - min(x, y), value)
+				}
+				divisor = GCD[uint64](product, value)
+			}
+		}
+		if divisor == value { // Batch contained the divisor together with its cofactor, replay it step by step
+			for divisor = 1; divisor == 1; {
+				saved = next(saved)
+				divisor = GCD[uint64](max(x, saved) - min(x, saved), value)
+			}
+		}
+		if divisor != value {
+			return divisor
+		}
+	}
+}
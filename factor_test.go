@@ -0,0 +1,94 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkFactors checks that `factors` are ascending primes with positive exponents, which product is `value`.
+func checkFactors(t *testing.T, value uint64, factors []Factor[uint64]) {
+	t.Helper()
+	product := uint64(1)
+	for i, factor := range factors {
+		if !IsPrime(factor.Prime) || factor.Exponent == 0 || i > 0 && factors[i - 1].Prime >= factor.Prime {
+			t.Fatalf("Factorize(%d) == %v", value, factors)
+		}
+		for range factor.Exponent {
+			product *= factor.Prime
+		}
+	}
+	if value <= 1 && len(factors) != 0 || value > 1 && product != value {
+		t.Fatalf("Factorize(%d) == %v", value, factors)
+	}
+}
+
+func TestFactorize(t *testing.T) {
+	tests := []struct {
+		value uint64
+		factors []Factor[uint64]
+	}{
+		{0, nil},
+		{1, nil},
+		{360, []Factor[uint64]{{2, 3}, {3, 2}, {5, 1}}},
+		{65537 * 65537, []Factor[uint64]{{65537, 2}}},
+		{257 * 257 * 257, []Factor[uint64]{{257, 3}}},
+		{65537 * 65537 * 65537 * 257, []Factor[uint64]{{257, 1}, {65537, 3}}},
+		{4294967291 * 4294967279, []Factor[uint64]{{4294967279, 1}, {4294967291, 1}}},
+		{4294967291 * 4294967291, []Factor[uint64]{{4294967291, 2}}},
+		{2147483647 * 2147483629 * 3, []Factor[uint64]{{3, 1}, {2147483629, 1}, {2147483647, 1}}},
+		{3215031751, []Factor[uint64]{{151, 1}, {751, 1}, {28351, 1}}},
+		{341550071728321, []Factor[uint64]{{10670053, 1}, {32010157, 1}}},
+		{3825123056546413051, []Factor[uint64]{{149491, 1}, {747451, 1}, {34233211, 1}}},
+		{18446744073709551557, []Factor[uint64]{{18446744073709551557, 1}}},
+		{1 << 64 - 1, []Factor[uint64]{{3, 1}, {5, 1}, {17, 1}, {257, 1}, {641, 1}, {65537, 1}, {6700417, 1}}},
+		{1 << 63, []Factor[uint64]{{2, 63}}},
+	}
+	for _, test := range tests {
+		if factors := Factorize[uint64](test.value); !slices.Equal(factors, test.factors) {
+			t.Errorf("Factorize(%d) == %v, want %v", test.value, factors, test.factors)
+		}
+	}
+	for value := range uint64(100000) {
+		checkFactors(t, value, Factorize[uint64](value))
+	}
+	random := rand.New(rand.NewPCG(7, 8))
+	for range 2000 {
+		value := random.Uint64()
+		checkFactors(t, value, Factorize[uint64](value))
+	}
+}
+
+// TestFactorizeMinimal checks factorization of Minimal for signed types, which absolute value does not fit into them.
+func TestFactorizeMinimal(t *testing.T) {
+	if factors := Factorize[uint8](Minimal[int8]()); !slices.Equal(factors, []Factor[uint8]{{2, 7}}) {
+		t.Errorf("Factorize(int8 Minimal) == %v", factors)
+	}
+	if factors := Factorize[uint16](Minimal[int16]()); !slices.Equal(factors, []Factor[uint16]{{2, 15}}) {
+		t.Errorf("Factorize(int16 Minimal) == %v", factors)
+	}
+	if factors := Factorize[uint32](Minimal[int32]()); !slices.Equal(factors, []Factor[uint32]{{2, 31}}) {
+		t.Errorf("Factorize(int32 Minimal) == %v", factors)
+	}
+	if factors := Factorize[uint64](Minimal[int64]()); !slices.Equal(factors, []Factor[uint64]{{2, 63}}) {
+		t.Errorf("Factorize(int64 Minimal) == %v", factors)
+	}
+	if factors := Factorize[uint16](int16(-360)); !slices.Equal(factors, []Factor[uint16]{{2, 3}, {3, 2}, {5, 1}}) {
+		t.Errorf("Factorize(-360) == %v", factors)
+	}
+}
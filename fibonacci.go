@@ -0,0 +1,81 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "math/bits"
+
+// Fibonacci(index) returns Fibonacci sequence member with corresponding index:
+// - Fibonacci(0) == 0
+// - Fibonacci(1) == Fibonacci(2) == 1
+// - Fibonacci(3) == 2
+// - Fibonacci(4) == 3
+// ...
+// Negative indexes produce results, extended for negative values:
+// - Fibonacci(-1) == 1
+// - Fibonacci(-2) == -1
+// - Fibonacci(-3) == 2
+// - Fibonacci(-4) == -3
+// ...
+// For unsigned T negative members wrap around like any other unsigned arithmetic.
+// Result is calculated via matrix ((1, 1), (1, 0)) fast raising to `index` power.
+func Fibonacci[T Integer, I Integer](index I) T {
+	v0, v1 := T(0), T(1) // Result vector
+	n := uint64(index)
+	if index < 0 {
+		n = -n
+		v1 = ^T(0) // -1
+	}
+
+	for m00, m01, m10, m11 := v1, v1, v1, v0; n != 0; n >>= 1 { // `n` fast division by 2
+		if IsOdd(n) {
+			v0, v1 = v0 * m00 + v1 * m10, v0 * m01 + v1 * m11 // If power is odd then multiply result vector by matrix
+		}
+		m00, m01, m10, m11 = m00 * m00 + m01 * m10, m00 * m01 + m01 * m11, m10 * m00 + m11 * m10, m10 * m01 + m11 * m11 // Square the matrix
+	}
+	return v0
+}
+
+// fibonacciMaxIndexes[i][s] is maximal index of Fibonacci sequence member, which fits into (8 << i)-bit type.
+// s is 0 for unsigned type and 1 for signed one.
+var fibonacciMaxIndexes = [4][2]uint{
+	{13, 11},
+	{24, 23},
+	{47, 46},
+	{93, 92},
+}
+
+// FibonacciMaxIndex returns maximal index, for which Fibonacci sequence member fits into T.
+// For signed T the same limit applies to absolute value of negative indexes.
+func FibonacciMaxIndex[T Integer]() uint {
+	i := bits.TrailingZeros(uint(Size[T]()))
+	if IsSigned[T]() {
+		return fibonacciMaxIndexes[i][1]
+	}
+	return fibonacciMaxIndexes[i][0]
+}
+
+// FibonacciChecked returns Fibonacci sequence member like Fibonacci, but detects overflow.
+// Second result is `true` if the member fits into T, otherwise it is `false`, and first result is meaningless.
+func FibonacciChecked[T Integer, I Integer](index I) (T, bool) {
+	n := absu[uint64](index)
+	if n > uint64(FibonacciMaxIndex[T]()) {
+		return 0, false
+	}
+	if index < 0 && !IsOdd(n) && n != 0 && !IsSigned[T]() { // Negative member
+		return 0, false
+	}
+	return Fibonacci[T](index), true
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math/big"
+	"testing"
+)
+
+// fibonacciBig returns exact Fibonacci sequence member with non-negative index.
+func fibonacciBig(index uint) *big.Int {
+	v0, v1 := big.NewInt(0), big.NewInt(1)
+	for range index {
+		v0, v1 = v1, v0.Add(v0, v1)
+	}
+	return v0
+}
+
+// checkFibonacciMaxIndex checks that members at FibonacciMaxIndex fit into T, while the next ones do not.
+func checkFibonacciMaxIndex[T Integer](t *testing.T) {
+	index := FibonacciMaxIndex[T]()
+	maximal := new(big.Int).SetUint64(uint64(Maximal[T]()))
+	if member := fibonacciBig(index); member.Cmp(maximal) > 0 || Fibonacci[T](index) != T(member.Uint64()) {
+		t.Errorf("Fibonacci(%d) == %v does not fit into %d-bit type", index, member, BitSize[T]())
+	}
+	if member := fibonacciBig(index + 1); member.Cmp(maximal) <= 0 {
+		t.Errorf("Fibonacci(%d) == %v fits into %d-bit type", index + 1, member, BitSize[T]())
+	}
+	if member, ok := FibonacciChecked[T](index); !ok || member != Fibonacci[T](index) {
+		t.Errorf("FibonacciChecked(%d) == %d, %v", index, member, ok)
+	}
+	if _, ok := FibonacciChecked[T](index + 1); ok {
+		t.Errorf("FibonacciChecked(%d) fits into %d-bit type", index + 1, BitSize[T]())
+	}
+	if IsSigned[T]() {
+		negative := -int(index)
+		if member, ok := FibonacciChecked[T](negative); !ok || member != Fibonacci[T](negative) {
+			t.Errorf("FibonacciChecked(%d) == %d, %v", negative, member, ok)
+		}
+		if _, ok := FibonacciChecked[T](negative - 1); ok {
+			t.Errorf("FibonacciChecked(%d) fits into %d-bit type", negative - 1, BitSize[T]())
+		}
+	}
+}
+
+func TestFibonacciMaxIndex(t *testing.T) {
+	checkFibonacciMaxIndex[int8](t)
+	checkFibonacciMaxIndex[int16](t)
+	checkFibonacciMaxIndex[int32](t)
+	checkFibonacciMaxIndex[int64](t)
+	checkFibonacciMaxIndex[uint8](t)
+	checkFibonacciMaxIndex[uint16](t)
+	checkFibonacciMaxIndex[uint32](t)
+	checkFibonacciMaxIndex[uint64](t)
+}
+
+func TestFibonacciNegative(t *testing.T) {
+	for index := -8; index <= 0; index++ {
+		member, ok := FibonacciChecked[uint64](index)
+		if index % 2 == 0 && index != 0 { // Negative member does not fit into unsigned type
+			if ok {
+				t.Errorf("FibonacciChecked[uint64](%d) == %d, true", index, member)
+			}
+		} else if want := fibonacciBig(uint(-index)).Uint64(); !ok || member != want {
+			t.Errorf("FibonacciChecked[uint64](%d) == %d, %v, want %d", index, member, ok, want)
+		}
+	}
+	if member, ok := FibonacciChecked[uint8](-13); !ok || member != 233 {
+		t.Errorf("FibonacciChecked[uint8](-13) == %d, %v", member, ok)
+	}
+	if member, ok := FibonacciChecked[int8](-10); !ok || member != -55 {
+		t.Errorf("FibonacciChecked[int8](-10) == %d, %v", member, ok)
+	}
+}
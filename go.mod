@@ -0,0 +1,3 @@
+module github.com/adam-lavrik/go-imath
+
+go 1.23
@@ -0,0 +1,49 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i16
+
+import (
+	"context"
+
+	"github.com/adam-lavrik/go-imath"
+)
+
+// ParallelOptions configures *Parallel functions: number of goroutines and minimal part size per goroutine.
+type ParallelOptions = imath.ParallelOptions
+
+// MinSliceParallel returns minimal of slice items, splitting large slices across goroutines.
+// Empty slice produces ErrEmpty, cancelled `ctx` produces its error.
+func MinSliceParallel(ctx context.Context, values []T, options ParallelOptions) (T, error) {
+	return imath.MinSliceParallel(ctx, values, options)
+}
+
+// MaxSliceParallel returns maximal of slice items, splitting large slices across goroutines.
+// Empty slice produces ErrEmpty, cancelled `ctx` produces its error.
+func MaxSliceParallel(ctx context.Context, values []T, options ParallelOptions) (T, error) {
+	return imath.MaxSliceParallel(ctx, values, options)
+}
+
+// MinMaxSliceParallel returns minimal (first) and maximal (second) of slice items, splitting large slices across goroutines.
+// Empty slice produces ErrEmpty, cancelled `ctx` produces its error.
+func MinMaxSliceParallel(ctx context.Context, values []T, options ParallelOptions) (T, T, error) {
+	return imath.MinMaxSliceParallel(ctx, values, options)
+}
+
+// SumParallel returns sum of slice items like Sum, splitting large slices across goroutines.
+// Cancelled `ctx` produces its error.
+func SumParallel(ctx context.Context, values []T, options ParallelOptions) (T, error) {
+	return imath.SumParallel(ctx, values, options)
+}
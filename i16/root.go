@@ -0,0 +1,37 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i16
+
+import "github.com/adam-lavrik/go-imath"
+
+// Root returns `k`-th root of `value`, rounded down.
+// Negative `value` produces negative root (rounded toward zero) for odd `k`.
+// Zero `k` and negative `value` with even `k` cause panic.
+func Root(value T, k uint) T {
+	return imath.Root(value, k)
+}
+
+// Cbrt returns cube root of `value`, rounded toward zero.
+func Cbrt(value T) T {
+	return imath.Cbrt(value)
+}
+
+// IsPerfectPower checks whether `value` is equal to `base` raised to `exponent` power, where |base| >= 2 and exponent >= 2.
+// The greatest possible `exponent` is returned.
+// Values 0, 1 and -1 produce `false`.
+func IsPerfectPower(value T) (T, uint, bool) {
+	return imath.IsPerfectPower(value)
+}
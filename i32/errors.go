@@ -0,0 +1,26 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i32
+
+import "github.com/adam-lavrik/go-imath"
+
+// Sentinel errors returned by *Err functions, shared with the root package and all other width packages.
+const (
+	ErrDivisionByZero = imath.ErrDivisionByZero
+	ErrEmpty = imath.ErrEmpty
+	ErrNegative = imath.ErrNegative
+	ErrOverflow = imath.ErrOverflow
+)
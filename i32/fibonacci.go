@@ -0,0 +1,41 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i32
+
+import "github.com/adam-lavrik/go-imath"
+
+// Fibonacci(index) returns Fibonacci sequence member with corresponding index:
+// - Fibonacci(0) == 0
+// - Fibonacci(1) == Fibonacci(2) == 1
+// - Fibonacci(3) == 2
+// - Fibonacci(4) == 3
+// ...
+// Negative indexes produce results, extended for negative values:
+// - Fibonacci(-1) == 1
+// - Fibonacci(-2) == -1
+// - Fibonacci(-3) == 2
+// - Fibonacci(-4) == -3
+// ...
+// Result is calculated via matrix ((1, 1), (1, 0)) fast raising to `index` power.
+func Fibonacci(index int) T {
+	return imath.Fibonacci[T](index)
+}
+
+// FibonacciChecked(index) returns Fibonacci sequence member like Fibonacci, but detects overflow.
+// Second result is `true` if the member fits into the type, otherwise it is `false`.
+func FibonacciChecked(index int) (T, bool) {
+	return imath.FibonacciChecked[T](index)
+}
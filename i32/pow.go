@@ -0,0 +1,52 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i32
+
+import "github.com/adam-lavrik/go-imath"
+
+// Pow raises `base` to `exponent` power.
+// Fast binary algorithm is used.
+// Pow(0, 0) == 1
+func Pow(base T, exponent uint) T {
+	return imath.Pow(base, exponent)
+}
+
+// PowChecked raises `base` to `exponent` power like Pow, but detects overflow.
+// Second result is `true` if the power fits into the type, otherwise it is `false`.
+func PowChecked(base T, exponent uint) (T, bool) {
+	return imath.PowChecked(base, exponent)
+}
+
+// PowSat raises `base` to `exponent` power like Pow, but clamps the result to [Minimal, Maximal] on overflow.
+func PowSat(base T, exponent uint) T {
+	return imath.PowSat(base, exponent)
+}
+
+// PowErr raises `base` to `exponent` power like Pow, but reports ErrOverflow if the power does not fit into the type.
+func PowErr(base T, exponent uint) (T, error) {
+	return imath.PowErr(base, exponent)
+}
+
+// PowMod raises `base` to `exponent` power modulo `modulus` without intermediate overflow.
+// Negative `base` is normalized, result is in [0, |modulus|) range. Zero `modulus` causes division by zero error.
+func PowMod(base T, exponent uint64, modulus T) T {
+	return imath.PowMod(base, exponent, modulus)
+}
+
+// MulMod returns product of `value_0` and `value_1` modulo `modulus` without intermediate overflow.
+func MulMod(value_0, value_1, modulus T) T {
+	return imath.MulMod(value_0, value_1, modulus)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i64
+
+import "github.com/adam-lavrik/go-imath"
+
+// CeilPow2 returns the least integer power of 2, which is not less than `value`, and `true`.
+// Values less than 1 produce 1. If the power does not fit into the type, second result is `false`.
+func CeilPow2(value T) (T, bool) {
+	return imath.CeilPow2(value)
+}
+
+// FloorPow2 returns the greatest integer power of 2, which is not greater than `value`, and `true`.
+// Zero and negative values produce `false`.
+func FloorPow2(value T) (T, bool) {
+	return imath.FloorPow2(value)
+}
+
+// AlignUp returns the least multiple of `alignment`, which is not less than `value`, and `true`.
+// If the result does not fit into the type or `alignment` is not positive, second result is `false`.
+func AlignUp(value, alignment T) (T, bool) {
+	return imath.AlignUp(value, alignment)
+}
+
+// AlignDown returns the greatest multiple of `alignment`, which is not greater than `value`, and `true`.
+// If the result does not fit into the type or `alignment` is not positive, second result is `false`.
+func AlignDown(value, alignment T) (T, bool) {
+	return imath.AlignDown(value, alignment)
+}
+
+// IsAligned checks whether `value` is a multiple of `alignment`. Non-positive `alignment` always produces `false`.
+func IsAligned(value, alignment T) bool {
+	return imath.IsAligned(value, alignment)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i64
+
+import (
+	"iter"
+
+	"github.com/adam-lavrik/go-imath"
+)
+
+// IsPrime checks whether `value` is a prime number. Values less than 2 (including negative ones) produce `false`.
+// Deterministic Miller-Rabin test with witness sets proven for 32 and 64 bits is used for large values.
+func IsPrime(value T) bool {
+	return imath.IsPrime(value)
+}
+
+// NextPrime returns the least prime number greater than `value` and `true`.
+// If there is no such prime in the type range, second result is `false`.
+func NextPrime(value T) (T, bool) {
+	return imath.NextPrime(value)
+}
+
+// PrevPrime returns the greatest prime number less than `value` and `true`.
+// If there is no such prime, second result is `false`.
+func PrevPrime(value T) (T, bool) {
+	return imath.PrevPrime(value)
+}
+
+// Primes returns iterator over prime numbers in [from, to) range in ascending order.
+func Primes(from, to T) iter.Seq[T] {
+	return imath.Primes(from, to)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i8
+
+import "github.com/adam-lavrik/go-imath"
+
+// Arg* functions return indexes of minimal and maximal slice items instead of the items themselves.
+// Ties are resolved to the first occurrence, or to the last one for *Last functions.
+// Empty slice produces -1 indexes (or nil for *All functions).
+
+func ArgMinSlice(values []T) int {
+	return imath.ArgMinSlice(values)
+}
+
+func ArgMinSliceLast(values []T) int {
+	return imath.ArgMinSliceLast(values)
+}
+
+// ArgMinSliceAll returns indexes of all occurrences of minimal item in ascending order.
+func ArgMinSliceAll(values []T) []int {
+	return imath.ArgMinSliceAll(values)
+}
+
+func ArgMaxSlice(values []T) int {
+	return imath.ArgMaxSlice(values)
+}
+
+func ArgMaxSliceLast(values []T) int {
+	return imath.ArgMaxSliceLast(values)
+}
+
+// ArgMaxSliceAll returns indexes of all occurrences of maximal item in ascending order.
+func ArgMaxSliceAll(values []T) []int {
+	return imath.ArgMaxSliceAll(values)
+}
+
+// ArgMinMaxSlice returns indexes of minimal (first) and maximal (second) items in a single pass.
+func ArgMinMaxSlice(values []T) (int, int) {
+	return imath.ArgMinMaxSlice(values)
+}
+
+func ArgMinMaxSliceLast(values []T) (int, int) {
+	return imath.ArgMinMaxSliceLast(values)
+}
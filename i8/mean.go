@@ -0,0 +1,48 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i8
+
+import "github.com/adam-lavrik/go-imath"
+
+// Midpoint returns (value_0 + value_1) / 2 without overflow. Halves are rounded toward `value_0`.
+func Midpoint(value_0, value_1 T) T {
+	return imath.Midpoint(value_0, value_1)
+}
+
+// MidpointFloor returns (value_0 + value_1) / 2 without overflow, rounded toward negative infinity.
+func MidpointFloor(value_0, value_1 T) T {
+	return imath.MidpointFloor(value_0, value_1)
+}
+
+// Average returns arithmetic mean of one or more integers without overflow, rounded toward negative infinity.
+func Average(value T, values ...T) T {
+	return imath.Average(value, values...)
+}
+
+// MeanSlice returns arithmetic mean of slice items like Average. Empty slice causes panic.
+func MeanSlice(values []T) T {
+	return imath.MeanSlice(values)
+}
+
+// MeanSliceChecked returns arithmetic mean of slice items like Average.
+// For an empty slice second result is `true`. Otherwise it is `false`, and first result is meaningful.
+func MeanSliceChecked(values []T) (T, bool) {
+	return imath.MeanSliceChecked(values)
+}
+
+// MeanSliceErr returns arithmetic mean of slice items like Average, but reports ErrEmpty for an empty slice.
+func MeanSliceErr(values []T) (T, error) {
+	return imath.MeanSliceErr(values)
+}
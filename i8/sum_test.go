@@ -0,0 +1,32 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package i8
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSumWide(t *testing.T) {
+	if sum := SumWide(slices.Repeat([]T{Minimal}, 1000)); sum != -128000 {
+		t.Errorf("SumWide(Minimal x 1000) == %d, want -128000", sum)
+	}
+	if sum := SumWide([]T{Maximal, Maximal, Minimal, -1}); sum != 125 {
+		t.Errorf("SumWide(Maximal, Maximal, Minimal, -1) == %d, want 125", sum)
+	}
+	if sum := SumWide(nil); sum != 0 {
+		t.Errorf("SumWide() == %d, want 0", sum)
+	}
+}
@@ -0,0 +1,486 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math/bits"
+	"slices"
+	"unsafe"
+)
+
+// Signed is a set of builtin signed integer types (and types derived from them).
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a set of builtin unsigned integer types (and types derived from them).
+// `uintptr` is not included.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Integer is a union of Signed and Unsigned.
+type Integer interface {
+	Signed | Unsigned
+}
+
+func Size[T Integer]() uintptr {
+	return unsafe.Sizeof(T(0))
+}
+
+func BitSize[T Integer]() uintptr {
+	return Size[T]() << 3
+}
+
+func Maximal[T Integer]() T {
+	maximal := ^T(0)
+	if maximal < 0 { // Signed type
+		maximal = ^(T(1) << (BitSize[T]() - 1))
+	}
+	return maximal
+}
+
+func Minimal[T Integer]() T {
+	return ^Maximal[T]()
+}
+
+func IsSigned[T Integer]() bool {
+	return ^T(0) < 0
+}
+
+func Abs[T Signed](value T) T {
+	signBit := SignBit(value)
+	return (value ^ signBit) + (signBit & 1)
+}
+
+// AbsChecked returns absolute value of `value` and `true`, if it fits into T.
+// For Minimal second result is `false`, and first result is meaningless.
+func AbsChecked[T Signed](value T) (T, bool) {
+	return Abs(value), value != Minimal[T]()
+}
+
+// AbsDiff returns exact distance |value_0 - value_1| between two integers as unsigned type U,
+// which must not be narrower than T.
+func AbsDiff[U Unsigned, T Integer](value_0, value_1 T) U {
+	if value_0 > value_1 { // Wrapping around of both conversions and difference gives exact result
+		return U(value_0) - U(value_1)
+	}
+	return U(value_1) - U(value_0)
+}
+
+// Absu returns absolute value of `value` as unsigned type U,
+// which must not be narrower than T (usually it is the complementary unsigned type).
+func Absu[U Unsigned, T Signed](value T) U {
+	signBit := SignBit(value)
+	return U(value ^ signBit) + U(signBit & 1)
+}
+
+// CRT solves the system of congruences x ≡ residues[i] (mod moduli[i]) using Chinese Remainder Theorem.
+// Moduli need not be pairwise coprime. Negative residues and moduli are normalized.
+// It returns the least non-negative solution `x` and combined modulus `m` (LCM of all moduli), so all solutions are x + k * m.
+// Third result is `false` if the system has no solution, `m` does not fit into T, any modulus is zero,
+// or slices have different lengths; otherwise it is `true`. Empty system produces (0, 1, true).
+func CRT[T Integer](residues, moduli []T) (T, T, bool) {
+	if len(residues) != len(moduli) {
+		return 0, 0, false
+	}
+	maximal := uint64(Maximal[T]())
+	x, m := uint64(0), uint64(1)
+	for i, modulus := range moduli {
+		if modulus == 0 {
+			return 0, 0, false
+		}
+		m2 := absu[uint64](modulus)
+		r2 := mod64(residues[i], m2)
+		g := GCD[uint64](m, m2)
+		difference := r2 - x % m2 // (r2 - x) mod m2
+		if r2 < x % m2 {
+			difference += m2
+		}
+		if difference % g != 0 { // Inconsistent congruences
+			return 0, 0, false
+		}
+		hi, lcm := bits.Mul64(m / g, m2)
+		if hi != 0 || lcm > maximal {
+			return 0, 0, false
+		}
+		m2 /= g
+		inverse, _ := ModInverse(m / g % m2, m2) // m / g and m2 / g are coprime
+		x += m * mulMod64(difference / g, inverse, m2)
+		m = lcm
+	}
+	return T(x), T(m), true
+}
+
+func Copysign[T Signed](target, source T) T {
+	if target == 0 {
+		return 0
+	}
+	source = SignBit(target ^ source)
+	return (target ^ source) + (source & 1)
+}
+
+func DivMod[T Integer](dividend, divisor T) (T, T) {
+	return dividend / divisor, dividend % divisor
+}
+
+// DivModErr returns quotient and remainder like DivMod, but reports ErrDivisionByZero for zero `divisor`
+// and ErrOverflow for Minimal / -1 instead of panicking or wrapping around.
+func DivModErr[T Integer](dividend, divisor T) (T, T, error) {
+	return divModErr(dividend, divisor, DivMod[T])
+}
+
+// divModErr calls `divMod` for `dividend` and `divisor`, if they do not cause division by zero or overflow.
+func divModErr[T Integer](dividend, divisor T, divMod func(T, T) (T, T)) (T, T, error) {
+	if divisor == 0 {
+		return 0, 0, ErrDivisionByZero
+	}
+	if IsSigned[T]() && divisor == ^T(0) && dividend == Minimal[T]() { // Minimal / -1
+		return 0, 0, ErrOverflow
+	}
+	quotient, remainder := divMod(dividend, divisor)
+	return quotient, remainder, nil
+}
+
+// ExtGCD returns greatest common divisor `g` of `value_0` and `value_1` as unsigned type U
+// with Bézout coefficients `x` and `y` of signed type S, so that value_0 * x + value_1 * y == g.
+// U and S must not be narrower than T.
+// Coefficients are minimal: |x| <= |value_1| / (2 * g) and |y| <= |value_0| / (2 * g), if both values are non-zero
+// and their absolute values differ (for equal ones x == 0 and |y| == 1), so they always fit into signed type of the same width as T.
+func ExtGCD[U Unsigned, S Signed, T Integer](value_0, value_1 T) (U, S, S) {
+	r0, r1 := absu[U](value_0), absu[U](value_1) // Remainders
+	x0, x1, y0, y1 := S(1), S(0), S(0), S(1) // Coefficients
+	for r1 != 0 {
+		q := r0 / r1
+		r0, r1 = r1, r0 - q * r1
+		x0, x1 = x1, x0 - S(q) * x1 // Wrapping around is harmless, since final coefficients fit into S
+		y0, y1 = y1, y0 - S(q) * y1
+	}
+	if value_0 < 0 {
+		x0 = -x0
+	}
+	if value_1 < 0 {
+		y0 = -y0
+	}
+	return r0, x0, y0
+}
+
+// GCD returns greatest common divisor as unsigned type U,
+// which must not be narrower than T.
+// Binary (Stein's) algorithm is used: it needs no division, only shifts and subtractions.
+func GCD[U Unsigned, T Integer](value_0, value_1 T) U {
+	u0, u1 := absu[U](value_0), absu[U](value_1)
+	if u0 == 0 {
+		return u1
+	}
+	if u1 == 0 {
+		return u0
+	}
+	shift := bits.TrailingZeros64(uint64(u0 | u1)) // Power of 2 common for both values
+	u0 >>= bits.TrailingZeros64(uint64(u0))
+	for { // u0 is always odd here
+		u1 >>= bits.TrailingZeros64(uint64(u1))
+		if u0 > u1 {
+			u0, u1 = u1, u0
+		}
+		if u1 -= u0; u1 == 0 {
+			return u0 << shift
+		}
+	}
+}
+
+// GCDs returns greatest common divisor of one or more integers as unsigned type U,
+// which must not be narrower than T.
+func GCDs[U Unsigned, T Integer](value T, values ...T) U {
+	gcd := absu[U](value)
+	for _, v := range values {
+		if gcd == 1 {
+			break
+		}
+		gcd = GCD[U](gcd, absu[U](v))
+	}
+	return gcd
+}
+
+// GCDSlice returns greatest common divisor of slice items as unsigned type U,
+// which must not be narrower than T. Empty slice produces 0.
+func GCDSlice[U Unsigned, T Integer](values []T) U {
+	if len(values) == 0 {
+		return 0
+	}
+	return GCDs[U](values[0], values[1:]...)
+}
+
+func Is2Power[T Integer](value T) bool {
+	return value > 0 && (value & (value - 1) == 0)
+}
+
+func IsOdd[T Integer](value T) bool {
+	return (value & 1) != 0
+}
+
+// LCM returns least common multiple as unsigned type U,
+// which must not be narrower than T.
+func LCM[U Unsigned, T Integer](value_0, value_1 T) U {
+	if value_0 == 0 || value_1 == 0 {
+		return 0
+	}
+	return absu[U](value_0) / GCD[U](value_0, value_1) * absu[U](value_1)
+}
+
+// LCMChecked returns least common multiple like LCM, but detects overflow.
+// Second result is `true` if the multiple fits into U, otherwise it is `false`, and first result is meaningless.
+func LCMChecked[U Unsigned, T Integer](value_0, value_1 T) (U, bool) {
+	if value_0 == 0 || value_1 == 0 {
+		return 0, true
+	}
+	return mulChecked(absu[U](value_0) / GCD[U](value_0, value_1), absu[U](value_1))
+}
+
+// LCMErr returns least common multiple like LCM, but reports ErrOverflow if it does not fit into U.
+func LCMErr[U Unsigned, T Integer](value_0, value_1 T) (U, error) {
+	lcm, ok := LCMChecked[U](value_0, value_1)
+	if !ok {
+		return 0, ErrOverflow
+	}
+	return lcm, nil
+}
+
+// LCMs returns least common multiple of one or more integers as unsigned type U,
+// which must not be narrower than T.
+func LCMs[U Unsigned, T Integer](value T, values ...T) U {
+	lcm := absu[U](value)
+	for _, v := range values {
+		if lcm == 0 {
+			break
+		}
+		lcm = LCM[U](lcm, absu[U](v))
+	}
+	return lcm
+}
+
+// LCMSlice returns least common multiple of slice items as unsigned type U,
+// which must not be narrower than T. Empty slice produces 1.
+func LCMSlice[U Unsigned, T Integer](values []T) U {
+	if len(values) == 0 {
+		return 1
+	}
+	return LCMs[U](values[0], values[1:]...)
+}
+
+// LCMSliceChecked returns least common multiple of slice items like LCMSlice, but stops at the first overflow.
+// Second result is `true` if the multiple fits into U, otherwise it is `false`, and first result is meaningless.
+func LCMSliceChecked[U Unsigned, T Integer](values []T) (U, bool) {
+	if slices.Contains(values, 0) { // Zero result fits whatever other items are
+		return 0, true
+	}
+	lcm, ok := U(1), true
+	for _, v := range values {
+		if lcm, ok = LCMChecked[U](lcm, absu[U](v)); !ok {
+			return 0, false
+		}
+	}
+	return lcm, true
+}
+
+func Min[T Integer](value_0, value_1 T) T {
+	if value_0 < value_1 {
+		return value_0
+	}
+	return value_1
+}
+
+// Mins returns minimal of one or more integers.
+// Loop is branchless (built-in min compiles to conditional moves) and uses 4 independent accumulators,
+// so its speed does not depend on data order and is not limited by latency of a single dependency chain.
+func Mins[T Integer](value T, values ...T) T {
+	m0, m1, m2, m3 := value, value, value, value
+	for len(values) >= 4 {
+		v := values[:4:4] // Eliminates bounds checks
+		m0, m1, m2, m3 = min(m0, v[0]), min(m1, v[1]), min(m2, v[2]), min(m3, v[3])
+		values = values[4:]
+	}
+	for _, v := range values {
+		m0 = min(m0, v)
+	}
+	return min(m0, m1, m2, m3)
+}
+
+func MinSlice[T Integer](values []T) T {
+	return Mins(values[0], values[1:]...)
+}
+
+func MinSliceChecked[T Integer](values []T) (T, bool) {
+	if len(values) == 0 {
+		return 0, true
+	}
+	return MinSlice(values), false
+}
+
+// MinSliceErr returns minimal of slice items like MinSlice, but reports ErrEmpty for an empty slice.
+func MinSliceErr[T Integer](values []T) (T, error) {
+	if len(values) == 0 {
+		return 0, ErrEmpty
+	}
+	return MinSlice(values), nil
+}
+
+func Max[T Integer](value_0, value_1 T) T {
+	if value_0 > value_1 {
+		return value_0
+	}
+	return value_1
+}
+
+// Maxs returns maximal of one or more integers. Loop is designed the same way as in Mins.
+func Maxs[T Integer](value T, values ...T) T {
+	m0, m1, m2, m3 := value, value, value, value
+	for len(values) >= 4 {
+		v := values[:4:4] // Eliminates bounds checks
+		m0, m1, m2, m3 = max(m0, v[0]), max(m1, v[1]), max(m2, v[2]), max(m3, v[3])
+		values = values[4:]
+	}
+	for _, v := range values {
+		m0 = max(m0, v)
+	}
+	return max(m0, m1, m2, m3)
+}
+
+func MaxSlice[T Integer](values []T) T {
+	return Maxs(values[0], values[1:]...)
+}
+
+func MaxSliceChecked[T Integer](values []T) (T, bool) {
+	if len(values) == 0 {
+		return 0, true
+	}
+	return MaxSlice(values), false
+}
+
+// MaxSliceErr returns maximal of slice items like MaxSlice, but reports ErrEmpty for an empty slice.
+func MaxSliceErr[T Integer](values []T) (T, error) {
+	if len(values) == 0 {
+		return 0, ErrEmpty
+	}
+	return MaxSlice(values), nil
+}
+
+func MinMax[T Integer](value_0, value_1 T) (T, T) {
+	if value_0 < value_1 {
+		return value_0, value_1
+	}
+	return value_1, value_0
+}
+
+// MinMaxs returns minimal (first) and maximal (second) of one or more integers.
+// Loop is designed the same way as in Mins, with 4 accumulators for each of the results.
+func MinMaxs[T Integer](value T, values ...T) (T, T) {
+	n0, n1, n2, n3 := value, value, value, value // Minimums
+	x0, x1, x2, x3 := value, value, value, value // Maximums
+	for len(values) >= 4 {
+		v := values[:4:4] // Eliminates bounds checks
+		n0, n1, n2, n3 = min(n0, v[0]), min(n1, v[1]), min(n2, v[2]), min(n3, v[3])
+		x0, x1, x2, x3 = max(x0, v[0]), max(x1, v[1]), max(x2, v[2]), max(x3, v[3])
+		values = values[4:]
+	}
+	for _, v := range values {
+		n0, x0 = min(n0, v), max(x0, v)
+	}
+	return min(n0, n1, n2, n3), max(x0, x1, x2, x3)
+}
+
+func MinMaxSlice[T Integer](values []T) (T, T) {
+	return MinMaxs(values[0], values[1:]...)
+}
+
+func MinMaxSliceChecked[T Integer](values []T) (T, T, bool) {
+	if len(values) == 0 {
+		return 0, 0, true
+	}
+	min, max := MinMaxSlice(values)
+	return min, max, false
+}
+
+// MinMaxSliceErr returns minimal and maximal of slice items like MinMaxSlice, but reports ErrEmpty for an empty slice.
+func MinMaxSliceErr[T Integer](values []T) (T, T, error) {
+	if len(values) == 0 {
+		return 0, 0, ErrEmpty
+	}
+	min, max := MinMaxSlice(values)
+	return min, max, nil
+}
+
+// ModInverse returns modular multiplicative inverse of `value` modulo `modulus` in [0, |modulus|) range
+// and `true`, if `value` and `modulus` are coprime, otherwise it returns `false`.
+// Zero `modulus` always produces `false`.
+func ModInverse[T Integer](value, modulus T) (T, bool) {
+	if modulus == 0 {
+		return 0, false
+	}
+	g, x, _ := ExtGCD[uint64, int64](value, modulus)
+	if g != 1 {
+		return 0, false
+	}
+	return T(mod64(x, absu[uint64](modulus))), true
+}
+
+// Sign returns -1, 0 or 1 for signed types and 0 or 1 for unsigned ones.
+func Sign[T Integer](value T) T {
+	shift := BitSize[T]() - 1
+	return value >> shift | (-value >> shift) & 1
+}
+
+func SignBit[T Signed](value T) T {
+	return value >> (BitSize[T]() - 1)
+}
+
+// absu is Absu, extended for unsigned types.
+func absu[U Unsigned, T Integer](value T) U {
+	if value < 0 {
+		return -U(value)
+	}
+	return U(value)
+}
+
+// addChecked returns sum of `value_0` and `value_1` and `true` if it fits into T, otherwise it returns `false`.
+func addChecked[T Integer](value_0, value_1 T) (T, bool) {
+	sum := value_0 + value_1
+	if value_1 < 0 {
+		return sum, sum < value_0
+	}
+	return sum, sum >= value_0
+}
+
+// subChecked returns difference of `minuend` and `subtrahend` and `true` if it fits into T, otherwise it returns `false`.
+func subChecked[T Integer](minuend, subtrahend T) (T, bool) {
+	difference := minuend - subtrahend
+	if subtrahend < 0 {
+		return difference, difference > minuend
+	}
+	return difference, difference <= minuend
+}
+
+// mulChecked returns product of `value_0` and `value_1` and `true` if it fits into T, otherwise it returns `false`.
+func mulChecked[T Integer](value_0, value_1 T) (T, bool) {
+	product := value_0 * value_1
+	if value_0 == 0 {
+		return product, true
+	}
+	if IsSigned[T]() && value_0 == ^T(0) && value_1 == Minimal[T]() { // Only -1 * Minimal is not caught by division check
+		return product, false
+	}
+	return product, product / value_0 == value_1
+}
@@ -0,0 +1,519 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+// gcdEuclid is the modulo-based Euclidean loop, which GCD used before switching to binary algorithm.
+func gcdEuclid[U Unsigned, T Integer](value_0, value_1 T) U {
+	for value_1 != 0 {
+		value_0, value_1 = value_1, value_0 % value_1
+	}
+	return absu[U](value_0)
+}
+
+// gcdPairs returns random 64-bit pairs shared by GCD benchmarks.
+func gcdPairs() [][2]uint64 {
+	random := rand.New(rand.NewPCG(1, 2))
+	pairs := make([][2]uint64, 1024)
+	for i := range pairs {
+		pairs[i] = [2]uint64{random.Uint64(), random.Uint64()}
+	}
+	return pairs
+}
+
+func TestGCD(t *testing.T) {
+	for _, pair := range gcdPairs() {
+		if gcd, euclid := GCD[uint64](pair[0], pair[1]), gcdEuclid[uint64](pair[0], pair[1]); gcd != euclid {
+			t.Fatalf("GCD(%d, %d) == %d, want %d", pair[0], pair[1], gcd, euclid)
+		}
+	}
+}
+
+func TestGCDLCMSlice(t *testing.T) {
+	tests := []struct {
+		values []int8
+		gcd, lcm uint8
+	}{
+		{[]int8{-4, 6, 10}, 2, 60},
+		{[]int8{48, 32}, 16, 96},
+		{[]int8{3, 0, 5}, 1, 0},
+		{[]int8{0, 127, 126}, 1, 0}, // Zero multiple stops reduction before overflow
+		{[]int8{3, 4, 0}, 1, 0}, // GCD stops at 1
+		{[]int8{-128, -128}, 128, 128},
+		{[]int8{-128, 64, 0}, 64, 0},
+		{[]int8{-128}, 128, 128},
+		{[]int8{0, 0}, 0, 0},
+		{nil, 0, 1},
+	}
+	for _, test := range tests {
+		if gcd := GCDSlice[uint8](test.values); gcd != test.gcd {
+			t.Errorf("GCDSlice(%v) == %d, want %d", test.values, gcd, test.gcd)
+		}
+		if lcm := LCMSlice[uint8](test.values); lcm != test.lcm {
+			t.Errorf("LCMSlice(%v) == %d, want %d", test.values, lcm, test.lcm)
+		}
+		if len(test.values) == 0 {
+			continue
+		}
+		if gcd := GCDs[uint8](test.values[0], test.values[1:]...); gcd != test.gcd {
+			t.Errorf("GCDs(%v) == %d, want %d", test.values, gcd, test.gcd)
+		}
+		if lcm := LCMs[uint8](test.values[0], test.values[1:]...); lcm != test.lcm {
+			t.Errorf("LCMs(%v) == %d, want %d", test.values, lcm, test.lcm)
+		}
+	}
+	if gcd := GCDSlice[uint64]([]int64{-1 << 63, 0}); gcd != 1 << 63 {
+		t.Errorf("GCDSlice(Minimal, 0) == %d", gcd)
+	}
+	if lcm := LCMs[uint64](int64(-1 << 63), 1 << 62, -2); lcm != 1 << 63 {
+		t.Errorf("LCMs(Minimal, 2 ^ 62, -2) == %d", lcm)
+	}
+}
+
+func TestDivModErr(t *testing.T) {
+	if quotient, remainder, err := DivModErr(int8(-7), 2); err != nil || quotient != -3 || remainder != -1 {
+		t.Errorf("DivModErr(-7, 2) == %d, %d, %v", quotient, remainder, err)
+	}
+	if _, _, err := DivModErr(int8(-128), -1); err != ErrOverflow {
+		t.Errorf("DivModErr(-128, -1) error == %v, want ErrOverflow", err)
+	}
+	if quotient, remainder, err := DivModErr(int8(-127), -1); err != nil || quotient != 127 || remainder != 0 {
+		t.Errorf("DivModErr(-127, -1) == %d, %d, %v", quotient, remainder, err)
+	}
+	if quotient, _, err := DivModErr(uint8(255), 255); err != nil || quotient != 1 { // 255 is not -1 for unsigned type
+		t.Errorf("DivModErr(255, 255) == %d, %v", quotient, err)
+	}
+	if _, _, err := DivModErr(int64(5), 0); err != ErrDivisionByZero {
+		t.Errorf("DivModErr(5, 0) error == %v, want ErrDivisionByZero", err)
+	}
+	if _, _, err := DivModErr(int64(-1 << 63), 0); err != ErrDivisionByZero {
+		t.Errorf("DivModErr(Minimal, 0) error == %v, want ErrDivisionByZero", err)
+	}
+}
+
+func TestSliceErr(t *testing.T) {
+	for _, values := range [][]int32{nil, {}} {
+		if _, err := MinSliceErr(values); err != ErrEmpty {
+			t.Errorf("MinSliceErr(%#v) error == %v, want ErrEmpty", values, err)
+		}
+		if _, err := MaxSliceErr(values); err != ErrEmpty {
+			t.Errorf("MaxSliceErr(%#v) error == %v, want ErrEmpty", values, err)
+		}
+		if _, _, err := MinMaxSliceErr(values); err != ErrEmpty {
+			t.Errorf("MinMaxSliceErr(%#v) error == %v, want ErrEmpty", values, err)
+		}
+	}
+	values := []int32{5, -1 << 31, 1 << 31 - 1, 0}
+	if min, err := MinSliceErr(values); err != nil || min != -1 << 31 {
+		t.Errorf("MinSliceErr(%v) == %d, %v", values, min, err)
+	}
+	if max, err := MaxSliceErr(values); err != nil || max != 1 << 31 - 1 {
+		t.Errorf("MaxSliceErr(%v) == %d, %v", values, max, err)
+	}
+	if min, max, err := MinMaxSliceErr(values); err != nil || min != -1 << 31 || max != 1 << 31 - 1 {
+		t.Errorf("MinMaxSliceErr(%v) == %d, %d, %v", values, min, max, err)
+	}
+}
+
+func TestAbsChecked(t *testing.T) {
+	if abs, ok := AbsChecked(int16(-5)); !ok || abs != 5 {
+		t.Errorf("AbsChecked(-5) == %d, %v", abs, ok)
+	}
+	if _, ok := AbsChecked(int16(-32768)); ok {
+		t.Error("AbsChecked(Minimal) fits")
+	}
+	if abs, ok := AbsChecked(int64(-1 << 63 + 1)); !ok || abs != 1 << 63 - 1 {
+		t.Errorf("AbsChecked(Minimal + 1) == %d, %v", abs, ok)
+	}
+	if abs, ok := AbsChecked(int8(127)); !ok || abs != 127 {
+		t.Errorf("AbsChecked(Maximal) == %d, %v", abs, ok)
+	}
+}
+
+func TestAbsDiff(t *testing.T) {
+	for value_0 := -128; value_0 < 128; value_0++ {
+		for value_1 := -128; value_1 < 128; value_1++ {
+			if diff := AbsDiff[uint8](int8(value_0), int8(value_1)); int(diff) != max(value_0 - value_1, value_1 - value_0) {
+				t.Fatalf("AbsDiff(%d, %d) == %d", value_0, value_1, diff)
+			}
+		}
+	}
+	if diff := AbsDiff[uint64](int64(-1 << 63), 1 << 63 - 1); diff != 1 << 64 - 1 {
+		t.Errorf("AbsDiff(Minimal, Maximal) == %d", diff)
+	}
+	if diff := AbsDiff[uint64](int64(1 << 63 - 1), -1 << 63); diff != 1 << 64 - 1 {
+		t.Errorf("AbsDiff(Maximal, Minimal) == %d", diff)
+	}
+	if diff := AbsDiff[uint8](uint8(3), 250); diff != 247 {
+		t.Errorf("AbsDiff(3, 250) == %d", diff)
+	}
+	if diff := AbsDiff[uint64](uint64(0), 1 << 64 - 1); diff != 1 << 64 - 1 {
+		t.Errorf("AbsDiff(0, Maximal) == %d", diff)
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		residues, moduli []int64
+		x, m int64
+		ok bool
+	}{
+		{[]int64{2, 3, 2}, []int64{3, 5, 7}, 23, 105, true},
+		{[]int64{1, 3}, []int64{4, 6}, 9, 12, true}, // Non-coprime moduli
+		{[]int64{1, 2}, []int64{4, 6}, 0, 0, false}, // Inconsistent congruences
+		{[]int64{-1, 5}, []int64{-4, 6}, 11, 12, true}, // Negative residue and modulus are normalized
+		{[]int64{7, 12}, []int64{5, 5}, 2, 5, true}, // Same modulus with equal residues
+		{[]int64{3}, []int64{-1 << 63}, 0, 0, false}, // |Minimal| does not fit into int64
+		{[]int64{1, 2}, []int64{3, 0}, 0, 0, false},
+		{[]int64{1, 2}, []int64{3}, 0, 0, false},
+		{nil, nil, 0, 1, true},
+	}
+	for _, test := range tests {
+		if x, m, ok := CRT(test.residues, test.moduli); ok != test.ok || ok && (x != test.x || m != test.m) {
+			t.Errorf("CRT(%v, %v) == %d, %d, %v, want %d, %d, %v",
+				test.residues, test.moduli, x, m, ok, test.x, test.m, test.ok)
+		}
+	}
+	if x, m, ok := CRT([]uint8{1, 2}, []uint8{15, 17}); !ok || x != 121 || m != 255 {
+		t.Errorf("CRT(1, 2; 15, 17) == %d, %d, %v", x, m, ok)
+	}
+	if _, _, ok := CRT([]uint8{1, 2}, []uint8{16, 17}); ok { // 272 does not fit into uint8
+		t.Error("CRT(1, 2; 16, 17) succeeded")
+	}
+	if _, _, ok := CRT([]int8{1, 2}, []int8{11, 12}); ok { // 132 does not fit into int8
+		t.Error("CRT(1, 2; 11, 12) succeeded")
+	}
+	if x, m, ok := CRT([]uint64{1, 2}, []uint64{1 << 32 - 1, 1 << 32 + 1}); !ok || x != 9223372034707292161 || m != 1 << 64 - 1 {
+		t.Errorf("CRT(1, 2; 2 ^ 32 - 1, 2 ^ 32 + 1) == %d, %d, %v", x, m, ok)
+	}
+	if _, _, ok := CRT([]uint64{1, 2}, []uint64{1 << 32, 1 << 32 + 1}); ok { // 2 ^ 64 + 2 ^ 32 does not fit into uint64
+		t.Error("CRT(1, 2; 2 ^ 32, 2 ^ 32 + 1) succeeded")
+	}
+}
+
+// checkExtGCD checks Bézout identity and minimality of coefficients with exact arithmetic.
+func checkExtGCD[T Integer](t *testing.T, value_0, value_1 T) {
+	g, x, y := ExtGCD[uint64, int64](value_0, value_1)
+	v0, v1 := big.NewInt(0), big.NewInt(0)
+	if IsSigned[T]() {
+		v0.SetInt64(int64(value_0))
+		v1.SetInt64(int64(value_1))
+	} else {
+		v0.SetUint64(uint64(value_0))
+		v1.SetUint64(uint64(value_1))
+	}
+	sum := new(big.Int).Add(new(big.Int).Mul(v0, big.NewInt(x)), new(big.Int).Mul(v1, big.NewInt(y)))
+	if gcd := new(big.Int).GCD(nil, nil, new(big.Int).Abs(v0), new(big.Int).Abs(v1)); !sum.IsUint64() || sum.Uint64() != g || gcd.Uint64() != g {
+		t.Errorf("ExtGCD(%d, %d) == %d, %d, %d", value_0, value_1, g, x, y)
+		return
+	}
+	if value_0 == 0 || value_1 == 0 || v0.CmpAbs(v1) == 0 {
+		return
+	}
+	doubled := new(big.Int).Lsh(new(big.Int).SetUint64(g), 1)
+	limitX, limitY := new(big.Int).Quo(new(big.Int).Abs(v1), doubled), new(big.Int).Quo(new(big.Int).Abs(v0), doubled)
+	if big.NewInt(x).CmpAbs(limitX) > 0 || big.NewInt(y).CmpAbs(limitY) > 0 {
+		t.Errorf("ExtGCD(%d, %d) coefficients %d, %d are not minimal", value_0, value_1, x, y)
+	}
+}
+
+func TestExtGCD(t *testing.T) {
+	if g, x, y := ExtGCD[uint8, int8](uint8(255), uint8(254)); g != 1 || x != 1 || y != -1 {
+		t.Errorf("ExtGCD(255, 254) == %d, %d, %d", g, x, y)
+	}
+	if g, x, y := ExtGCD[uint8, int8](uint8(254), uint8(255)); g != 1 || x != -1 || y != 1 {
+		t.Errorf("ExtGCD(254, 255) == %d, %d, %d", g, x, y)
+	}
+	if g, x, y := ExtGCD[uint8, int8](uint8(3), uint8(3)); g != 3 || x != 0 || y != 1 { // Equal values are not bounded by |value| / (2 * g)
+		t.Errorf("ExtGCD(3, 3) == %d, %d, %d", g, x, y)
+	}
+	if g, x, y := ExtGCD[uint8, int8](int8(-3), int8(3)); g != 3 || x != 0 || y != 1 {
+		t.Errorf("ExtGCD(-3, 3) == %d, %d, %d", g, x, y)
+	}
+	if g, x, y := ExtGCD[uint, int](240, 46); g != 2 || x != -9 || y != 47 {
+		t.Errorf("ExtGCD(240, 46) == %d, %d, %d", g, x, y)
+	}
+	if g, x, y := ExtGCD[uint64, int64](int64(-1 << 63), 0); g != 1 << 63 || x != -1 || y != 0 {
+		t.Errorf("ExtGCD(Minimal, 0) == %d, %d, %d", g, x, y)
+	}
+	if g, x, y := ExtGCD[uint64, int64](int64(-1 << 63), -1 << 63); g != 1 << 63 || x != 0 || y != -1 {
+		t.Errorf("ExtGCD(Minimal, Minimal) == %d, %d, %d", g, x, y)
+	}
+	for _, pair := range [][2]uint64{
+		{1 << 64 - 1, 1 << 64 - 2}, {1 << 64 - 1, 1 << 63}, {1 << 64 - 59, 1 << 64 - 83},
+		{1 << 64 - 1, 1 << 32 + 1}, {12200160415121876738, 7540113804746346429}, // Consecutive Fibonacci numbers
+	} {
+		checkExtGCD(t, pair[0], pair[1])
+	}
+	for _, pair := range [][2]int64{{-1 << 63, 1 << 63 - 1}, {-1 << 63, 3}, {1 << 63 - 1, -(1 << 63 - 2)}, {-240, 46}} {
+		checkExtGCD(t, pair[0], pair[1])
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	tests := []struct {
+		value, modulus, inverse int64
+		ok bool
+	}{
+		{3, 11, 4, true},
+		{-3, 11, 7, true},
+		{3, -11, 4, true}, // Sign of modulus does not matter
+		{3, -1 << 63, 3074457345618258603, true},
+		{5, 1, 0, true},
+		{4, 10, 0, false},
+		{6, -9, 0, false},
+		{0, 7, 0, false},
+		{2, -1 << 63, 0, false},
+		{3, 0, 0, false},
+	}
+	for _, test := range tests {
+		if inverse, ok := ModInverse(test.value, test.modulus); ok != test.ok || ok && inverse != test.inverse {
+			t.Errorf("ModInverse(%d, %d) == %d, %v, want %d, %v", test.value, test.modulus, inverse, ok, test.inverse, test.ok)
+		}
+	}
+	if inverse, ok := ModInverse(int8(5), -128); !ok || inverse != 77 {
+		t.Errorf("ModInverse(5, -128) == %d, %v", inverse, ok)
+	}
+	if inverse, ok := ModInverse(uint64(1 << 64 - 3), 1 << 64 - 1); !ok || inverse != 1 << 63 - 1 {
+		t.Errorf("ModInverse(2 ^ 64 - 3, 2 ^ 64 - 1) == %d, %v", inverse, ok)
+	}
+}
+
+func TestLCMChecked(t *testing.T) {
+	tests := []struct {
+		value_0, value_1, lcm uint64
+		ok bool
+	}{
+		{1 << 32 - 1, 1 << 32 + 1, 1 << 64 - 1, true},
+		{1 << 32, 1 << 32 + 1, 0, false},
+		{1 << 64 - 1, 1 << 64 - 1, 1 << 64 - 1, true},
+		{1 << 63, 3, 0, false},
+		{0, 1 << 64 - 1, 0, true},
+	}
+	for _, test := range tests {
+		if lcm, ok := LCMChecked[uint64](test.value_0, test.value_1); ok != test.ok || ok && lcm != test.lcm {
+			t.Errorf("LCMChecked(%d, %d) == %d, %v", test.value_0, test.value_1, lcm, ok)
+		}
+	}
+	if lcm, ok := LCMChecked[uint8](uint8(15), 17); !ok || lcm != 255 {
+		t.Errorf("LCMChecked(15, 17) == %d, %v", lcm, ok)
+	}
+	if _, ok := LCMChecked[uint8](int8(-128), 3); ok {
+		t.Error("LCMChecked(-128, 3) fits into uint8")
+	}
+	if lcm, ok := LCMChecked[uint8](int8(-128), -128); !ok || lcm != 128 {
+		t.Errorf("LCMChecked(-128, -128) == %d, %v", lcm, ok)
+	}
+	if _, ok := LCMChecked[uint64](int64(-1 << 63), 1 << 63 - 1); ok {
+		t.Error("LCMChecked(Minimal, Maximal) fits into uint64")
+	}
+	if _, err := LCMErr[uint16](int16(257), 256); err != ErrOverflow {
+		t.Errorf("LCMErr(257, 256) error == %v, want ErrOverflow", err)
+	}
+}
+
+func TestLCMSliceChecked(t *testing.T) {
+	tests := []struct {
+		values []uint8
+		lcm uint8
+		ok bool
+	}{
+		{[]uint8{4, 6, 10}, 60, true},
+		{[]uint8{16, 9, 5}, 0, false},
+		{[]uint8{16, 9, 5, 0}, 0, true}, // Zero item wins over overflow
+		{[]uint8{255, 15, 17, 5}, 255, true},
+		{[]uint8{}, 1, true},
+	}
+	for _, test := range tests {
+		if lcm, ok := LCMSliceChecked[uint8](test.values); ok != test.ok || ok && lcm != test.lcm {
+			t.Errorf("LCMSliceChecked(%v) == %d, %v", test.values, lcm, ok)
+		}
+	}
+	if lcm, ok := LCMSliceChecked[uint8]([]int8{-128, 64, -2}); !ok || lcm != 128 {
+		t.Errorf("LCMSliceChecked(-128, 64, -2) == %d, %v", lcm, ok)
+	}
+	if _, ok := LCMSliceChecked[uint64]([]int64{-1 << 63, 3}); ok {
+		t.Error("LCMSliceChecked(Minimal, 3) fits into uint64")
+	}
+}
+
+// BenchmarkGCD compares binary GCD with the Euclidean loop on random 64-bit values.
+func BenchmarkGCD(b *testing.B) {
+	pairs := gcdPairs()
+	var sink uint64
+	b.Run("Binary", func(b *testing.B) {
+		for i := range b.N {
+			pair := pairs[i % len(pairs)]
+			sink += GCD[uint64](pair[0], pair[1])
+		}
+	})
+	b.Run("Euclid", func(b *testing.B) {
+		for i := range b.N {
+			pair := pairs[i % len(pairs)]
+			sink += gcdEuclid[uint64](pair[0], pair[1])
+		}
+	})
+	_ = sink
+}
+
+// minsBranching, maxsBranching and minMaxsBranching are branching loops, which Mins, Maxs and MinMaxs used before
+// switching to branchless kernels.
+
+func minsBranching[T Integer](value T, values ...T) T {
+	for _, v := range values {
+		if v < value {
+			value = v
+		}
+	}
+	return value
+}
+
+func maxsBranching[T Integer](value T, values ...T) T {
+	for _, v := range values {
+		if v > value {
+			value = v
+		}
+	}
+	return value
+}
+
+func minMaxsBranching[T Integer](value T, values ...T) (T, T) {
+	min := value
+	max := value
+	for _, v := range values {
+		if v < min {
+			min = v
+		} else if v > max {
+			max = v
+		}
+	}
+	return min, max
+}
+
+// sliceSizes are lengths of slices used by min/max benchmarks.
+var sliceSizes = []int{1_000, 100_000, 1_000_000, 10_000_000}
+
+// sliceOrders are generators of slices with different order of values, used by min/max tests and benchmarks.
+// Branching loop predicts well on random values, but descending values update minimum at every item,
+// and alternating ones update both minimum and maximum at every other item.
+var sliceOrders = []struct {
+	name string
+	values func(size int) []int64
+}{
+	{"Random", randomSlice},
+	{"Descending", descendingSlice},
+	{"Alternating", alternatingSlice},
+}
+
+// randomSlice returns slice of random int64 values.
+func randomSlice(size int) []int64 {
+	random := rand.New(rand.NewPCG(3, 4))
+	values := make([]int64, size)
+	for i := range values {
+		values[i] = random.Int64() - 1 << 62
+	}
+	return values
+}
+
+// descendingSlice returns slice of strictly descending int64 values.
+func descendingSlice(size int) []int64 {
+	values := make([]int64, size)
+	for i := range values {
+		values[i] = int64(size - i)
+	}
+	return values
+}
+
+// alternatingSlice returns slice of int64 values with alternating signs and growing absolute values: 0, -1, 2, -3...
+func alternatingSlice(size int) []int64 {
+	values := make([]int64, size)
+	for i := range values {
+		values[i] = int64(i)
+		if IsOdd(i) {
+			values[i] = -values[i]
+		}
+	}
+	return values
+}
+
+func TestMinMaxSlice(t *testing.T) {
+	for _, order := range sliceOrders {
+		for size := 1; size <= 64; size++ {
+			values := order.values(size)
+			if min, want := MinSlice(values), minsBranching(values[0], values[1:]...); min != want {
+				t.Fatalf("MinSlice of %d %s items == %d, want %d", size, order.name, min, want)
+			}
+			if max, want := MaxSlice(values), maxsBranching(values[0], values[1:]...); max != want {
+				t.Fatalf("MaxSlice of %d %s items == %d, want %d", size, order.name, max, want)
+			}
+			min, max := MinMaxSlice(values)
+			if wantMin, wantMax := minMaxsBranching(values[0], values[1:]...); min != wantMin || max != wantMax {
+				t.Fatalf("MinMaxSlice of %d %s items == %d, %d, want %d, %d", size, order.name, min, max, wantMin, wantMax)
+			}
+		}
+	}
+}
+
+// benchmarkSlice runs branchless `kernel` against `branching` loop on slices of sliceSizes in every order of sliceOrders.
+func benchmarkSlice(b *testing.B, kernel, branching func([]int64)) {
+	for _, order := range sliceOrders {
+		for _, size := range sliceSizes {
+			values := order.values(size)
+			b.Run(fmt.Sprintf("%s/Branchless/%d", order.name, size), func(b *testing.B) {
+				for range b.N {
+					kernel(values)
+				}
+			})
+			b.Run(fmt.Sprintf("%s/Branching/%d", order.name, size), func(b *testing.B) {
+				for range b.N {
+					branching(values)
+				}
+			})
+		}
+	}
+}
+
+var sink int64
+
+func BenchmarkMinSlice(b *testing.B) {
+	benchmarkSlice(b,
+		func(values []int64) { sink += MinSlice(values) },
+		func(values []int64) { sink += minsBranching(values[0], values[1:]...) })
+}
+
+func BenchmarkMaxSlice(b *testing.B) {
+	benchmarkSlice(b,
+		func(values []int64) { sink += MaxSlice(values) },
+		func(values []int64) { sink += maxsBranching(values[0], values[1:]...) })
+}
+
+func BenchmarkMinMaxSlice(b *testing.B) {
+	benchmarkSlice(b,
+		func(values []int64) {
+			min, max := MinMaxSlice(values)
+			sink += min + max
+		},
+		func(values []int64) {
+			min, max := minMaxsBranching(values[0], values[1:]...)
+			sink += min + max
+		})
+}
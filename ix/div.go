@@ -0,0 +1,103 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package ix
+
+import "github.com/adam-lavrik/go-imath"
+
+// Division functions with various rounding of quotient.
+// Remainder always satisfies dividend == quotient * divisor + remainder.
+// Zero divisor causes division by zero error.
+// The only overflowing case, Minimal / -1, wraps around to Minimal like built-in division does.
+// *Err functions report these cases as ErrDivisionByZero and ErrOverflow instead.
+
+// DivModFloor returns quotient rounded toward negative infinity and remainder with sign of divisor.
+func DivModFloor(dividend, divisor T) (T, T) {
+	return imath.DivModFloor(dividend, divisor)
+}
+
+func DivFloor(dividend, divisor T) T {
+	return imath.DivFloor(dividend, divisor)
+}
+
+// DivModCeil returns quotient rounded toward positive infinity and remainder with sign opposite to divisor.
+func DivModCeil(dividend, divisor T) (T, T) {
+	return imath.DivModCeil(dividend, divisor)
+}
+
+func DivCeil(dividend, divisor T) T {
+	return imath.DivCeil(dividend, divisor)
+}
+
+// DivModEuclid returns quotient and remainder of Euclidean division: remainder is always non-negative.
+func DivModEuclid(dividend, divisor T) (T, T) {
+	return imath.DivModEuclid(dividend, divisor)
+}
+
+func DivEuclid(dividend, divisor T) T {
+	return imath.DivEuclid(dividend, divisor)
+}
+
+func ModEuclid(dividend, divisor T) T {
+	return imath.ModEuclid(dividend, divisor)
+}
+
+// DivModRound returns quotient rounded to the nearest integer (halves are rounded away from zero) and remainder.
+func DivModRound(dividend, divisor T) (T, T) {
+	return imath.DivModRound(dividend, divisor)
+}
+
+func DivRound(dividend, divisor T) T {
+	return imath.DivRound(dividend, divisor)
+}
+
+// DivModRoundEven returns quotient rounded to the nearest integer (halves are rounded to even) and remainder.
+func DivModRoundEven(dividend, divisor T) (T, T) {
+	return imath.DivModRoundEven(dividend, divisor)
+}
+
+func DivRoundEven(dividend, divisor T) T {
+	return imath.DivRoundEven(dividend, divisor)
+}
+
+// DivModFloorErr returns quotient rounded toward negative infinity and remainder like DivModFloor,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModFloorErr(dividend, divisor T) (T, T, error) {
+	return imath.DivModFloorErr(dividend, divisor)
+}
+
+// DivModCeilErr returns quotient rounded toward positive infinity and remainder like DivModCeil,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModCeilErr(dividend, divisor T) (T, T, error) {
+	return imath.DivModCeilErr(dividend, divisor)
+}
+
+// DivModEuclidErr returns quotient of Euclidean division and remainder like DivModEuclid,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModEuclidErr(dividend, divisor T) (T, T, error) {
+	return imath.DivModEuclidErr(dividend, divisor)
+}
+
+// DivModRoundErr returns quotient rounded to the nearest integer (halves away from zero) and remainder like DivModRound,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModRoundErr(dividend, divisor T) (T, T, error) {
+	return imath.DivModRoundErr(dividend, divisor)
+}
+
+// DivModRoundEvenErr returns quotient rounded to the nearest integer (halves to even) and remainder like DivModRoundEven,
+// but reports ErrDivisionByZero for zero `divisor` and ErrOverflow for Minimal / -1.
+func DivModRoundEvenErr(dividend, divisor T) (T, T, error) {
+	return imath.DivModRoundEvenErr(dividend, divisor)
+}
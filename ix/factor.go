@@ -0,0 +1,27 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package ix
+
+import "github.com/adam-lavrik/go-imath"
+
+// Factor is a prime factor of integer with its multiplicity.
+type Factor = imath.Factor[UT]
+
+// Factorize returns prime factors of |value| with their exponents in ascending order of primes, and sign of `value` (-1, 0 or 1).
+// 0, 1 and -1 have no prime factors.
+func Factorize(value T) ([]Factor, T) {
+	return imath.Factorize[UT](value), imath.Sign(value)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package ix
+
+import "github.com/adam-lavrik/go-imath"
+
+// Integer logarithms are rounded down (or up for *Ceil functions) and returned with `true`.
+// Zero and negative values produce `false`.
+
+func Log2(value T) (uint, bool) {
+	return imath.Log2(value)
+}
+
+func Log2Ceil(value T) (uint, bool) {
+	return imath.Log2Ceil(value)
+}
+
+func Log10(value T) (uint, bool) {
+	return imath.Log10(value)
+}
+
+func Log10Ceil(value T) (uint, bool) {
+	return imath.Log10Ceil(value)
+}
+
+// Log returns logarithm of `value` to `base`. `base` less than 2 produces `false`.
+func Log(value, base T) (uint, bool) {
+	return imath.Log(value, base)
+}
+
+// LogCeil returns logarithm of `value` to `base`, rounded up. `base` less than 2 produces `false`.
+func LogCeil(value, base T) (uint, bool) {
+	return imath.LogCeil(value, base)
+}
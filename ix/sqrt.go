@@ -0,0 +1,53 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package ix
+
+import "github.com/adam-lavrik/go-imath"
+
+// Sqrt returns square root of `value`, rounded down.
+// Result is exact for the whole range of the type.
+// Negative `value` causes panic.
+func Sqrt(value T) T {
+	return imath.Sqrt(value)
+}
+
+// SqrtCeil returns square root of `value`, rounded up.
+// Negative `value` causes panic.
+func SqrtCeil(value T) T {
+	return imath.SqrtCeil(value)
+}
+
+// SqrtRem returns square root of `value`, rounded down, and remainder value - root * root.
+// Negative `value` causes panic.
+func SqrtRem(value T) (T, T) {
+	return imath.SqrtRem(value)
+}
+
+// SqrtChecked returns square root of `value`, rounded down, and `true`.
+// Negative `value` produces `false` instead of panic.
+func SqrtChecked(value T) (T, bool) {
+	return imath.SqrtChecked(value)
+}
+
+// SqrtErr is the same as SqrtChecked, but negative `value` produces ErrNegative.
+func SqrtErr(value T) (T, error) {
+	return imath.SqrtErr(value)
+}
+
+// IsSquare checks whether `value` is a square of integer. Negative values always produce `false`.
+func IsSquare(value T) bool {
+	return imath.IsSquare(value)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package ix
+
+import "github.com/adam-lavrik/go-imath"
+
+// Sum returns sum of slice items. Overflow wraps around. Empty slice produces 0.
+func Sum(values []T) T {
+	return imath.Sum(values)
+}
+
+// SumChecked returns sum of slice items and `true` if it fits into the type, otherwise it returns `false`.
+// Intermediate sums never overflow, so only the final result matters.
+func SumChecked(values []T) (T, bool) {
+	return imath.SumChecked(values)
+}
+
+// SumErr returns sum of slice items like SumChecked, but reports ErrOverflow if it does not fit into the type.
+func SumErr(values []T) (T, error) {
+	return imath.SumErr(values)
+}
+
+// SumWide returns sum of slice items as 128-bit signed integer: high (`hi`) and low (`lo`) 64-bit halves.
+// It never overflows.
+func SumWide(values []T) (int64, uint64) {
+	return imath.SumWide(values)
+}
+
+// Product returns product of slice items. Overflow wraps around. Empty slice produces 1.
+func Product(values []T) T {
+	return imath.Product(values)
+}
+
+// ProductChecked returns product of slice items and `true` if it fits into the type, otherwise it returns `false`.
+func ProductChecked(values []T) (T, bool) {
+	return imath.ProductChecked(values)
+}
+
+// ProductErr returns product of slice items like ProductChecked, but reports ErrOverflow if it does not fit into the type.
+func ProductErr(values []T) (T, error) {
+	return imath.ProductErr(values)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "math/bits"
+
+// Integer logarithms are rounded down (or up for *Ceil functions) and returned with `true`.
+// Zero and negative `value` produce `false`.
+
+func Log2[T Integer](value T) (uint, bool) {
+	if value <= 0 {
+		return 0, false
+	}
+	return uint(bits.Len64(uint64(value)) - 1), true
+}
+
+func Log2Ceil[T Integer](value T) (uint, bool) {
+	log, ok := Log2(value)
+	if ok && !Is2Power(value) {
+		log++
+	}
+	return log, ok
+}
+
+func Log10[T Integer](value T) (uint, bool) {
+	if value <= 0 {
+		return 0, false
+	}
+	v := uint64(value)
+	log := uint(bits.Len64(v)) * 1233 >> 12 // Approximation of log10(2) as 1233 / 4096, can be greater by 1
+	if v < powers10[log] {
+		log--
+	}
+	return log, true
+}
+
+func Log10Ceil[T Integer](value T) (uint, bool) {
+	log, ok := Log10(value)
+	if ok && uint64(value) != powers10[log] {
+		log++
+	}
+	return log, ok
+}
+
+// Log returns logarithm of `value` to `base`, rounded down, and `true`.
+// Zero and negative `value`, as well as `base` less than 2 produce `false`.
+func Log[T Integer](value, base T) (uint, bool) {
+	if value <= 0 || base < 2 {
+		return 0, false
+	}
+	log := uint(0)
+	for value >= base {
+		value /= base
+		log++
+	}
+	return log, true
+}
+
+// LogCeil returns logarithm of `value` to `base`, rounded up, and `true`.
+// Zero and negative `value`, as well as `base` less than 2 produce `false`.
+func LogCeil[T Integer](value, base T) (uint, bool) {
+	if value <= 0 || base < 2 {
+		return 0, false
+	}
+	log, exact := uint(0), true // Value is an exact power of base while all divisions have no remainder
+	for value >= base {
+		exact = exact && value % base == 0
+		value /= base
+		log++
+	}
+	if !exact || value != 1 {
+		log++
+	}
+	return log, true
+}
+
+// powers10[i] == 10 ^ i
+var powers10 = [...]uint64{
+	1,
+	10,
+	100,
+	1000,
+	10000,
+	100000,
+	1000000,
+	10000000,
+	100000000,
+	1000000000,
+	10000000000,
+	100000000000,
+	1000000000000,
+	10000000000000,
+	100000000000000,
+	1000000000000000,
+	10000000000000000,
+	100000000000000000,
+	1000000000000000000,
+	10000000000000000000,
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "testing"
+
+// logBoundaries returns 1, powers of 2 and of 10 within uint64 range with their neighbours, and Maximal.
+func logBoundaries() []uint64 {
+	values := []uint64{1, 1 << 64 - 1}
+	for k := 1; k < 64; k++ {
+		values = append(values, 1 << k - 1, 1 << k, 1 << k + 1)
+	}
+	for _, power := range powers10[1:] {
+		values = append(values, power - 1, power, power + 1)
+	}
+	return values
+}
+
+// logRef returns logarithm of `value` to `base`, rounded down and up, by plain repeated division.
+func logRef(value, base uint64) (uint, uint) {
+	log, power := uint(0), uint64(1)
+	for v := value; v >= base; v /= base {
+		log++
+		power *= base
+	}
+	if power == value {
+		return log, log
+	}
+	return log, log + 1
+}
+
+func TestLog2Log10(t *testing.T) {
+	for _, value := range logBoundaries() {
+		floor, ceil := logRef(value, 2)
+		if log, ok := Log2(value); !ok || log != floor {
+			t.Errorf("Log2(%d) == %d, %v, want %d", value, log, ok, floor)
+		}
+		if log, ok := Log2Ceil(value); !ok || log != ceil {
+			t.Errorf("Log2Ceil(%d) == %d, %v, want %d", value, log, ok, ceil)
+		}
+		floor, ceil = logRef(value, 10)
+		if log, ok := Log10(value); !ok || log != floor {
+			t.Errorf("Log10(%d) == %d, %v, want %d", value, log, ok, floor)
+		}
+		if log, ok := Log10Ceil(value); !ok || log != ceil {
+			t.Errorf("Log10Ceil(%d) == %d, %v, want %d", value, log, ok, ceil)
+		}
+		for _, base := range []uint64{2, 3, 10, 255, 1 << 32, 1 << 64 - 1} {
+			floor, _ := logRef(value, base)
+			if log, ok := Log(value, base); !ok || log != floor {
+				t.Errorf("Log(%d, %d) == %d, %v, want %d", value, base, log, ok, floor)
+			}
+		}
+	}
+	for value := int8(1); value > 0; value++ { // All positive int8 values up to overflow
+		floor, ceil := logRef(uint64(value), 10)
+		if log, ok := Log10(value); !ok || log != floor {
+			t.Errorf("Log10(int8(%d)) == %d, %v, want %d", value, log, ok, floor)
+		}
+		if log, ok := Log10Ceil(value); !ok || log != ceil {
+			t.Errorf("Log10Ceil(int8(%d)) == %d, %v, want %d", value, log, ok, ceil)
+		}
+		floor, ceil = logRef(uint64(value), 2)
+		if log, ok := Log2Ceil(value); !ok || log != ceil {
+			t.Errorf("Log2Ceil(int8(%d)) == %d, %v, want %d", value, log, ok, ceil)
+		}
+	}
+	for _, value := range []int8{0, -1, -128} {
+		if _, ok := Log2(value); ok {
+			t.Errorf("Log2(%d) succeeded", value)
+		}
+		if _, ok := Log2Ceil(value); ok {
+			t.Errorf("Log2Ceil(%d) succeeded", value)
+		}
+		if _, ok := Log10(value); ok {
+			t.Errorf("Log10(%d) succeeded", value)
+		}
+		if _, ok := Log10Ceil(value); ok {
+			t.Errorf("Log10Ceil(%d) succeeded", value)
+		}
+		if _, ok := Log(value, 2); ok {
+			t.Errorf("Log(%d, 2) succeeded", value)
+		}
+	}
+	for _, base := range []int64{1, 0, -2} {
+		if _, ok := Log(int64(100), base); ok {
+			t.Errorf("Log(100, %d) succeeded", base)
+		}
+	}
+	if log, ok := Log(int64(1 << 63 - 1), 2); !ok || log != 62 {
+		t.Errorf("Log(Maximal, 2) == %d, %v", log, ok)
+	}
+}
+
+func TestLogCeil(t *testing.T) {
+	for base := uint64(2); base <= 40; base++ {
+		for value := uint64(1); value <= 100000; value++ {
+			want, power := uint(0), uint64(1)
+			for power < value {
+				power *= base
+				want++
+			}
+			if log, ok := LogCeil(value, base); !ok || log != want {
+				t.Fatalf("LogCeil(%d, %d) == %d, %v, want %d", value, base, log, ok, want)
+			}
+		}
+	}
+	tests := []struct {
+		value, base uint64
+		log uint
+	}{
+		{1 << 64 - 1, 2, 64}, {1 << 63, 2, 63}, {10000000000000000000, 10, 19}, {10000000000000000001, 10, 20},
+		{1 << 64 - 1, 1 << 64 - 1, 1}, {1 << 64 - 2, 1 << 64 - 1, 1},
+	}
+	for _, test := range tests {
+		if log, ok := LogCeil(test.value, test.base); !ok || log != test.log {
+			t.Errorf("LogCeil(%d, %d) == %d, %v, want %d", test.value, test.base, log, ok, test.log)
+		}
+	}
+	if _, ok := LogCeil(int8(-8), 2); ok {
+		t.Error("LogCeil(-8, 2) succeeded")
+	}
+	if _, ok := LogCeil(8, 1); ok {
+		t.Error("LogCeil(8, 1) succeeded")
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "math/bits"
+
+// Midpoint returns (value_0 + value_1) / 2 without overflow. Halves are rounded toward `value_0`,
+// so Midpoint(low, high) never reaches `high` and Midpoint(high, low) never reaches `low`.
+func Midpoint[T Integer](value_0, value_1 T) T {
+	midpoint := MidpointFloor(value_0, value_1)
+	if (value_0 ^ value_1) & 1 != 0 && value_0 > value_1 { // Half, rounded down, but `value_0` is greater
+		midpoint++
+	}
+	return midpoint
+}
+
+// MidpointFloor returns (value_0 + value_1) / 2 without overflow, rounded toward negative infinity.
+func MidpointFloor[T Integer](value_0, value_1 T) T {
+	return (value_0 & value_1) + ((value_0 ^ value_1) >> 1) // Common bits plus half of different ones
+}
+
+// Average returns arithmetic mean of one or more integers, rounded toward negative infinity.
+// Sum is accumulated with carry into 128 bits, so it never overflows.
+func Average[T Integer](value T, values ...T) T {
+	hi, lo := sum128(values)
+	hi, lo = add128(hi, lo, value)
+	return mean128[T](hi, lo, uint64(len(values)) + 1)
+}
+
+// MeanSlice returns arithmetic mean of slice items like Average. Empty slice causes panic.
+func MeanSlice[T Integer](values []T) T {
+	return Average(values[0], values[1:]...)
+}
+
+// MeanSliceChecked returns arithmetic mean of slice items like Average.
+// For an empty slice second result is `true`. Otherwise it is `false`, and first result is meaningful.
+func MeanSliceChecked[T Integer](values []T) (T, bool) {
+	if len(values) == 0 {
+		return 0, true
+	}
+	return MeanSlice(values), false
+}
+
+// MeanSliceErr returns arithmetic mean of slice items like Average, but reports ErrEmpty for an empty slice.
+func MeanSliceErr[T Integer](values []T) (T, error) {
+	if len(values) == 0 {
+		return 0, ErrEmpty
+	}
+	return MeanSlice(values), nil
+}
+
+// sum128 returns sum of `values` as 128-bit two's complement integer.
+func sum128[T Integer](values []T) (uint64, uint64) {
+	hi, lo := uint64(0), uint64(0)
+	for _, v := range values {
+		hi, lo = add128(hi, lo, v)
+	}
+	return hi, lo
+}
+
+// add128 adds `value` to 128-bit two's complement integer (hi, lo).
+func add128[T Integer](hi, lo uint64, value T) (uint64, uint64) {
+	var carry uint64
+	lo, carry = bits.Add64(lo, uint64(value), 0) // Conversion extends sign of negative value
+	hi += carry
+	if value < 0 {
+		hi-- // Add sign extension ^uint64(0) to the high half
+	}
+	return hi, lo
+}
+
+// mean128 returns 128-bit two's complement integer (hi, lo) divided by `count`, rounded toward negative infinity.
+// Result must fit into T.
+func mean128[T Integer](hi, lo, count uint64) T {
+	negative := int64(hi) < 0
+	if negative { // Divide absolute value
+		hi, lo = ^hi, -lo
+		if lo == 0 {
+			hi++
+		}
+	}
+	quotient, remainder := bits.Div64(hi, lo, count) // hi < count, since the result fits into 64 bits
+	if negative {
+		if remainder != 0 {
+			quotient++ // Round absolute value up, so negative result is rounded down
+		}
+		quotient = -quotient
+	}
+	return T(quotient)
+}
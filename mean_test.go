@@ -0,0 +1,98 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMidpoint(t *testing.T) {
+	tests := []struct {
+		value_0, value_1, midpoint, floor int64
+	}{
+		{1 << 63 - 2, 1 << 63 - 1, 1 << 63 - 2, 1 << 63 - 2},
+		{1 << 63 - 1, 1 << 63 - 2, 1 << 63 - 1, 1 << 63 - 2},
+		{-3, -4, -3, -4},
+		{-1 << 63, 1 << 63 - 1, -1, -1},
+		{1 << 63 - 1, -1 << 63, 0, -1},
+		{-1 << 63, -1 << 63, -1 << 63, -1 << 63},
+		{-5, 5, 0, 0},
+	}
+	for _, test := range tests {
+		if midpoint := Midpoint(test.value_0, test.value_1); midpoint != test.midpoint {
+			t.Errorf("Midpoint(%d, %d) == %d, want %d", test.value_0, test.value_1, midpoint, test.midpoint)
+		}
+		if floor := MidpointFloor(test.value_0, test.value_1); floor != test.floor {
+			t.Errorf("MidpointFloor(%d, %d) == %d, want %d", test.value_0, test.value_1, floor, test.floor)
+		}
+	}
+	if midpoint := Midpoint(uint8(255), 254); midpoint != 255 {
+		t.Errorf("Midpoint(255, 254) == %d, want 255", midpoint)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	tests := []struct {
+		values []int64
+		mean int64
+	}{
+		{[]int64{-1, 0}, -1}, // Mixed signs are rounded toward negative infinity
+		{[]int64{-5, 2}, -2},
+		{[]int64{-3, 0, 0, 0}, -1},
+		{[]int64{-4, 0, 0, 0}, -1},
+		{[]int64{-1 << 63, 1 << 63 - 1}, -1},
+		{[]int64{-1 << 63, 1 << 63 - 1, 1 << 63 - 1}, (1 << 63 - 2) / 3},
+		{slices.Repeat([]int64{-1 << 63}, 1000), -1 << 63},
+		{slices.Repeat([]int64{1 << 63 - 1}, 1000), 1 << 63 - 1},
+		{[]int64{7}, 7},
+	}
+	for _, test := range tests {
+		if mean := Average(test.values[0], test.values[1:]...); mean != test.mean {
+			t.Errorf("Average(%v) == %d, want %d", test.values, mean, test.mean)
+		}
+	}
+	if mean := Average(uint64(1 << 64 - 1), 1 << 64 - 3); mean != 1 << 64 - 2 {
+		t.Errorf("Average(Maximal, Maximal - 2) == %d, want Maximal - 1", mean)
+	}
+	if mean := MeanSlice(slices.Repeat([]uint64{1 << 64 - 1}, 1000)); mean != 1 << 64 - 1 {
+		t.Errorf("MeanSlice(Maximal...) == %d, want Maximal", mean)
+	}
+	if mean := MeanSlice([]int8{-128, -128, 127}); mean != -43 {
+		t.Errorf("MeanSlice(-128, -128, 127) == %d, want -43", mean)
+	}
+	if mean := MeanSlice([]int8{-128, -128, -128}); mean != -128 {
+		t.Errorf("MeanSlice(-128, -128, -128) == %d, want -128", mean)
+	}
+}
+
+func TestMeanSliceEmpty(t *testing.T) {
+	if _, empty := MeanSliceChecked([]int32{}); !empty {
+		t.Error("MeanSliceChecked() is not empty")
+	}
+	if _, err := MeanSliceErr([]int32(nil)); err != ErrEmpty {
+		t.Errorf("MeanSliceErr() error == %v, want ErrEmpty", err)
+	}
+	if mean, err := MeanSliceErr([]uint16{1, 2}); err != nil || mean != 1 {
+		t.Errorf("MeanSliceErr(1, 2) == %d, %v", mean, err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MeanSlice() did not panic")
+		}
+	}()
+	MeanSlice([]int32{})
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// DefaultParallelThreshold is the default minimal part size of *Parallel functions, so by default they start goroutines for slices of 2 * DefaultParallelThreshold items or longer.
+const DefaultParallelThreshold = 1 << 16
+
+// ParallelOptions configures *Parallel functions. Zero value means defaults.
+type ParallelOptions struct {
+	Workers int // Maximal number of goroutines; non-positive value means runtime.GOMAXPROCS(0)
+	Threshold int // Minimal part size: every part has at least Threshold items, so slices shorter than 2 * Threshold are processed in the calling goroutine; non-positive value means DefaultParallelThreshold
+}
+
+// parallelBlock is the number of items processed between checks of context cancellation.
+const parallelBlock = 1 << 16
+
+// MinSliceParallel returns minimal of slice items like MinSlice, splitting large slices across goroutines.
+// Empty slice produces ErrEmpty, cancelled `ctx` produces its error.
+func MinSliceParallel[T Integer](ctx context.Context, values []T, options ParallelOptions) (T, error) {
+	if len(values) == 0 {
+		return 0, ErrEmpty
+	}
+	return parallelReduce(ctx, values, options, MinSlice[T], Min[T])
+}
+
+// MaxSliceParallel returns maximal of slice items like MaxSlice, splitting large slices across goroutines.
+// Empty slice produces ErrEmpty, cancelled `ctx` produces its error.
+func MaxSliceParallel[T Integer](ctx context.Context, values []T, options ParallelOptions) (T, error) {
+	if len(values) == 0 {
+		return 0, ErrEmpty
+	}
+	return parallelReduce(ctx, values, options, MaxSlice[T], Max[T])
+}
+
+// MinMaxSliceParallel returns minimal (first) and maximal (second) of slice items like MinMaxSlice,
+// splitting large slices across goroutines.
+// Empty slice produces ErrEmpty, cancelled `ctx` produces its error.
+func MinMaxSliceParallel[T Integer](ctx context.Context, values []T, options ParallelOptions) (T, T, error) {
+	if len(values) == 0 {
+		return 0, 0, ErrEmpty
+	}
+	result, err := parallelReduce(ctx, values, options,
+		func(values []T) [2]T {
+			min, max := MinMaxSlice(values)
+			return [2]T{min, max}
+		},
+		func(result_0, result_1 [2]T) [2]T {
+			return [2]T{Min(result_0[0], result_1[0]), Max(result_0[1], result_1[1])}
+		},
+	)
+	return result[0], result[1], err
+}
+
+// SumParallel returns sum of slice items like Sum, splitting large slices across goroutines.
+// Cancelled `ctx` produces its error.
+func SumParallel[T Integer](ctx context.Context, values []T, options ParallelOptions) (T, error) {
+	if len(values) == 0 {
+		return 0, ctx.Err()
+	}
+	return parallelReduce(ctx, values, options, Sum[T], func(sum_0, sum_1 T) T { return sum_0 + sum_1 })
+}
+
+// parallelReduce applies `reduce` to non-empty parts of non-empty `values` and combines partial results with `merge`.
+// `values` are split into parts between goroutines, each of them is processed by blocks to react on `ctx` cancellation.
+func parallelReduce[T Integer, R any](ctx context.Context, values []T, options ParallelOptions, reduce func([]T) R, merge func(R, R) R) (R, error) {
+	workers, threshold := options.Workers, options.Threshold
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if threshold <= 0 {
+		threshold = DefaultParallelThreshold
+	}
+	workers = min(workers, len(values) / threshold) // No goroutine gets less than threshold items
+	if workers <= 1 {
+		return reduceBlocks(ctx, values, reduce, merge)
+	}
+
+	partSize, remainder := len(values) / workers, len(values) % workers // Not less than threshold
+	results := make([]R, workers)
+	errs := make([]error, workers)
+	var group sync.WaitGroup
+	for w, start := 0, 0; w < workers; w++ {
+		end := start + partSize
+		if w < remainder { // Remainder is spread one item each over the first parts
+			end++
+		}
+		part := values[start:end]
+		start = end
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			results[w], errs[w] = reduceBlocks(ctx, part, reduce, merge)
+		}()
+	}
+	group.Wait()
+
+	result := results[0]
+	for w := range workers {
+		if errs[w] != nil {
+			var zero R
+			return zero, errs[w]
+		}
+		if w > 0 {
+			result = merge(result, results[w])
+		}
+	}
+	return result, nil
+}
+
+// reduceBlocks applies `reduce` to non-empty `values` by blocks, checking `ctx` cancellation between them.
+func reduceBlocks[T Integer, R any](ctx context.Context, values []T, reduce func([]T) R, merge func(R, R) R) (R, error) {
+	var result R
+	for i := 0; i < len(values); i += parallelBlock {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		partial := reduce(values[i:min(i + parallelBlock, len(values))])
+		if i == 0 {
+			result = partial
+		} else {
+			result = merge(result, partial)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	random := rand.New(rand.NewPCG(5, 6))
+	ctx := context.Background()
+	for _, size := range []int{1, 999, 1000, 50_000, 3 * parallelBlock + 7} {
+		values := make([]int32, size)
+		for i := range values {
+			values[i] = random.Int32() - 1 << 30
+		}
+		wantMin, wantMax := MinMaxSlice(values)
+		wantSum := Sum(values)
+		for _, options := range []ParallelOptions{{}, {Workers: 1}, {Workers: 3, Threshold: 1000}, {Workers: 64, Threshold: 1}} {
+			if min, err := MinSliceParallel(ctx, values, options); err != nil || min != wantMin {
+				t.Errorf("MinSliceParallel of %d items with %+v == %d, %v, want %d", size, options, min, err, wantMin)
+			}
+			if max, err := MaxSliceParallel(ctx, values, options); err != nil || max != wantMax {
+				t.Errorf("MaxSliceParallel of %d items with %+v == %d, %v, want %d", size, options, max, err, wantMax)
+			}
+			if min, max, err := MinMaxSliceParallel(ctx, values, options); err != nil || min != wantMin || max != wantMax {
+				t.Errorf("MinMaxSliceParallel of %d items with %+v == %d, %d, %v", size, options, min, max, err)
+			}
+			if sum, err := SumParallel(ctx, values, options); err != nil || sum != wantSum {
+				t.Errorf("SumParallel of %d items with %+v == %d, %v, want %d", size, options, sum, err, wantSum)
+			}
+		}
+	}
+	if _, err := MinSliceParallel(ctx, []int8{}, ParallelOptions{}); err != ErrEmpty {
+		t.Errorf("MinSliceParallel of empty slice error == %v", err)
+	}
+}
+
+func TestParallelCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, size := range []int{0, 1, 3 * parallelBlock} {
+		values := make([]uint64, size)
+		options := ParallelOptions{Workers: 4, Threshold: 1}
+		if size > 0 {
+			if _, err := MinSliceParallel(ctx, values, options); err != context.Canceled {
+				t.Errorf("MinSliceParallel of %d items error == %v", size, err)
+			}
+			if _, err := MaxSliceParallel(ctx, values, options); err != context.Canceled {
+				t.Errorf("MaxSliceParallel of %d items error == %v", size, err)
+			}
+			if _, _, err := MinMaxSliceParallel(ctx, values, options); err != context.Canceled {
+				t.Errorf("MinMaxSliceParallel of %d items error == %v", size, err)
+			}
+		}
+		if sum, err := SumParallel(ctx, values, options); err != context.Canceled || sum != 0 {
+			t.Errorf("SumParallel of %d items == %d, %v", size, sum, err)
+		}
+	}
+}
+
+// TestParallelThreshold checks that slices are split into parts of at least Threshold items.
+func TestParallelThreshold(t *testing.T) {
+	tests := []struct {
+		size int
+		options ParallelOptions
+		parts int
+	}{
+		{50_000, ParallelOptions{Workers: 4, Threshold: 1000}, 4},
+		{50_000, ParallelOptions{Workers: 100, Threshold: 1000}, 50},
+		{999, ParallelOptions{Workers: 4, Threshold: 1000}, 1},
+		{1999, ParallelOptions{Workers: 4, Threshold: 1000}, 1}, // Splitting starts at 2 * Threshold
+		{2000, ParallelOptions{Workers: 4, Threshold: 1000}, 2},
+		{3001, ParallelOptions{Workers: 3, Threshold: 1000}, 3}, // Remainder must not shorten the last part
+		{2999, ParallelOptions{Workers: 3, Threshold: 1000}, 2},
+		{50_000, ParallelOptions{Workers: 4}, 1},
+	}
+	for _, test := range tests {
+		var lock sync.Mutex
+		var lengths []int
+		sum, err := parallelReduce(context.Background(), make([]int, test.size), test.options,
+			func(values []int) int {
+				lock.Lock()
+				defer lock.Unlock()
+				lengths = append(lengths, len(values))
+				return len(values)
+			},
+			func(sum_0, sum_1 int) int { return sum_0 + sum_1 },
+		)
+		if err != nil || sum != test.size {
+			t.Errorf("parallelReduce of %d items with %+v == %d, %v", test.size, test.options, sum, err)
+		}
+		if len(lengths) != test.parts || slices.Min(lengths) < min(test.size, test.options.Threshold) {
+			t.Errorf("%d items with %+v are split into %v, want %d parts", test.size, test.options, lengths, test.parts)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "math/bits"
+
+// Pow raises `base` to `exponent` power.
+// Fast binary algorithm is used.
+// Pow(0, 0) == 1
+func Pow[T Integer](base T, exponent uint) T {
+	power := T(1)
+	for exponent > 0 {
+		if IsOdd(exponent) {
+			power *= base
+		}
+		base *= base
+		exponent >>= 1 // `exponent` fast division by 2
+	}
+	return power
+}
+
+// PowChecked raises `base` to `exponent` power like Pow, but detects overflow.
+// Second result is `true` if the power fits into T, otherwise it is `false`, and first result is meaningless.
+func PowChecked[T Integer](base T, exponent uint) (T, bool) {
+	power, ok := T(1), true
+	for exponent > 0 {
+		if IsOdd(exponent) {
+			if power, ok = mulChecked(power, base); !ok {
+				return 0, false
+			}
+		}
+		exponent >>= 1 // `exponent` fast division by 2
+		if exponent > 0 { // Squared base is needed only if there are more exponent bits
+			if base, ok = mulChecked(base, base); !ok {
+				return 0, false
+			}
+		}
+	}
+	return power, true
+}
+
+// PowErr raises `base` to `exponent` power like Pow, but reports ErrOverflow if the power does not fit into T.
+func PowErr[T Integer](base T, exponent uint) (T, error) {
+	power, ok := PowChecked(base, exponent)
+	if !ok {
+		return 0, ErrOverflow
+	}
+	return power, nil
+}
+
+// PowMod raises `base` to `exponent` power modulo `modulus`.
+// Intermediate products are 128-bit wide, so no overflow is possible.
+// Result is always in [0, |modulus|) range, negative `base` is normalized first.
+// Zero `modulus` causes division by zero error.
+func PowMod[T Integer](base T, exponent uint64, modulus T) T {
+	m := absu[uint64](modulus)
+	return T(powMod64(mod64(base, m), exponent, m))
+}
+
+// MulMod returns product of `value_0` and `value_1` modulo `modulus` without overflow.
+// Result is always in [0, |modulus|) range.
+// Zero `modulus` causes division by zero error.
+func MulMod[T Integer](value_0, value_1, modulus T) T {
+	m := absu[uint64](modulus)
+	return T(mulMod64(mod64(value_0, m), mod64(value_1, m), m))
+}
+
+// mod64 returns `value` modulo `modulus` in [0, modulus) range.
+func mod64[T Integer](value T, modulus uint64) uint64 {
+	remainder := absu[uint64](value) % modulus
+	if value < 0 && remainder != 0 {
+		remainder = modulus - remainder
+	}
+	return remainder
+}
+
+func mulMod64(value_0, value_1, modulus uint64) uint64 {
+	hi, lo := bits.Mul64(value_0, value_1)
+	return bits.Rem64(hi, lo, modulus)
+}
+
+// powMod64 is PowMod for `base` already reduced modulo `modulus`.
+func powMod64(base, exponent, modulus uint64) uint64 {
+	power := 1 % modulus
+	for exponent > 0 {
+		if IsOdd(exponent) {
+			power = mulMod64(power, base, modulus)
+		}
+		base = mulMod64(base, base, modulus)
+		exponent >>= 1 // `exponent` fast division by 2
+	}
+	return power
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math/bits"
+	"testing"
+)
+
+func TestPowChecked(t *testing.T) {
+	checkInt64 := func(base int64, exponent uint, power int64, ok bool) {
+		if p, o := PowChecked(base, exponent); o != ok || ok && p != power {
+			t.Errorf("PowChecked(%d, %d) == %d, %v", base, exponent, p, o)
+		}
+	}
+	checkInt64(2, 62, 1 << 62, true)
+	checkInt64(2, 63, 0, false)
+	checkInt64(-2, 63, -1 << 63, true)
+	checkInt64(-2, 64, 0, false)
+	checkInt64(-1, 1 << 63 + 1, -1, true)
+	checkInt64(0, 0, 1, true)
+	if power, ok := PowChecked(uint64(2), 63); !ok || power != 1 << 63 {
+		t.Errorf("PowChecked(2, 63) == %d, %v", power, ok)
+	}
+	if _, ok := PowChecked(uint64(2), 64); ok {
+		t.Error("PowChecked(2, 64) fits into uint64")
+	}
+	if power, ok := PowChecked(uint64(1 << 32), 1); !ok || power != 1 << 32 { // Base must not be squared after the last bit
+		t.Errorf("PowChecked(2 ^ 32, 1) == %d, %v", power, ok)
+	}
+	if _, ok := PowChecked(uint64(1 << 32), 2); ok {
+		t.Error("PowChecked(2 ^ 32, 2) fits into uint64")
+	}
+	if power, ok := PowChecked(int8(-2), 7); !ok || power != -128 {
+		t.Errorf("PowChecked(-2, 7) == %d, %v", power, ok)
+	}
+	if _, ok := PowChecked(int8(2), 7); ok {
+		t.Error("PowChecked(2, 7) fits into int8")
+	}
+	if bits.UintSize == 64 {
+		if power, ok := PowChecked(uint(3), 40); !ok || power != 12157665459056928801 {
+			t.Errorf("PowChecked(3, 40) == %d, %v", power, ok)
+		}
+		if _, ok := PowChecked(uint(3), 41); ok {
+			t.Error("PowChecked(3, 41) fits into uint")
+		}
+	}
+	if _, err := PowErr(int16(-2), 16); err != ErrOverflow {
+		t.Errorf("PowErr(-2, 16) error == %v, want ErrOverflow", err)
+	}
+}
+
+func TestPowMod(t *testing.T) {
+	tests := []struct {
+		base, exponent, modulus, power uint64
+	}{
+		{3, 200, 18446744073709551557, 13293435361704887469},
+		{2, 18446744073709551556, 18446744073709551557, 1}, // Fermat test with 64-bit exponent
+		{2, 1 << 64 - 1, 1 << 64 - 1, 1 << 63 % (1 << 64 - 1)}, // 2 ^ 64 == 1 modulo 2 ^ 64 - 1
+		{12345, 0, 1, 0},
+		{0, 0, 7, 1},
+	}
+	for _, test := range tests {
+		if power := PowMod(test.base, test.exponent, test.modulus); power != test.power {
+			t.Errorf("PowMod(%d, %d, %d) == %d, want %d", test.base, test.exponent, test.modulus, power, test.power)
+		}
+	}
+	if power := PowMod(int32(-3), 3, 100); power != 73 {
+		t.Errorf("PowMod(-3, 3, 100) == %d, want 73", power)
+	}
+}
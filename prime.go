@@ -0,0 +1,146 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// IsPrime checks whether `value` is a prime number. Values less than 2 (including negative ones) produce `false`.
+// The test is deterministic for the whole 64-bit range:
+// - values below 2 ^ 8 are looked up in a bit table;
+// - values below 2 ^ 16 are checked by trial division by primes below 2 ^ 8;
+// - greater values are checked by Miller-Rabin test with witness sets, proven to be sufficient for 32 and 64 bits.
+func IsPrime[T Integer](value T) bool {
+	if value < 2 {
+		return false
+	}
+	return isPrime64(uint64(value))
+}
+
+// NextPrime returns the least prime number greater than `value` and `true`.
+// If there is no such prime in T range, second result is `false`.
+func NextPrime[T Integer](value T) (T, bool) {
+	if value < 2 {
+		return 2, true
+	}
+	v, maximal := uint64(value), uint64(Maximal[T]())
+	for candidate := (v + 1) | 1; candidate > v && candidate <= maximal; candidate += 2 { // Odd candidates until overflow
+		if isPrime64(candidate) {
+			return T(candidate), true
+		}
+	}
+	return 0, false
+}
+
+// PrevPrime returns the greatest prime number less than `value` and `true`.
+// If there is no such prime (`value` is not greater than 2), second result is `false`.
+func PrevPrime[T Integer](value T) (T, bool) {
+	if value <= 2 {
+		return 0, false
+	}
+	if value == 3 {
+		return 2, true
+	}
+	for candidate := (uint64(value) - 2) | 1; ; candidate -= 2 { // Odd candidates down to 3, which is prime
+		if isPrime64(candidate) {
+			return T(candidate), true
+		}
+	}
+}
+
+// Primes returns iterator over prime numbers in [from, to) range in ascending order.
+func Primes[T Integer](from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if from <= 2 {
+			if to <= 2 || !yield(2) {
+				return
+			}
+			from = 3
+		}
+		for prime, ok := NextPrime(from - 1); ok && prime < to; prime, ok = NextPrime(prime) {
+			if !yield(prime) {
+				return
+			}
+		}
+	}
+}
+
+// primes8 is a bit table of primes below 2 ^ 8: bit (value & 63) of primes8[value >> 6] is set for prime `value`.
+var primes8 = [4]uint64{0x28208a20a08a28ac, 0x800228a202088288, 0x8028208820a00a08, 0x08028228800800a2}
+
+// smallPrimes are primes below 2 ^ 8.
+var smallPrimes = [...]uint64{
+	2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67, 71, 73, 79, 83, 89, 97,
+	101, 103, 107, 109, 113, 127, 131, 137, 139, 149, 151, 157, 163, 167, 173, 179, 181, 191, 193, 197, 199,
+	211, 223, 227, 229, 233, 239, 241, 251,
+}
+
+// Witnesses of deterministic Miller-Rabin test:
+// - {2, 7, 61} are sufficient for values below 4759123141 (Jaeschke, 1993);
+// - 7 bases found by Jim Sinclair are sufficient for values below 2 ^ 64.
+var (
+	witnesses32 = [...]uint64{2, 7, 61}
+	witnesses64 = [...]uint64{2, 325, 9375, 28178, 450775, 9780504, 1795265022}
+)
+
+func isPrime64(value uint64) bool {
+	if value < 1 << 8 {
+		return primes8[value >> 6] >> (value & 63) & 1 != 0
+	}
+	for _, p := range smallPrimes {
+		if value % p == 0 {
+			return false
+		}
+		if p * p > value {
+			return true
+		}
+	}
+	if value < 1 << 16 { // All primes up to square root have been tried
+		return true
+	}
+	if value < 1 << 32 {
+		return millerRabin(value, witnesses32[:])
+	}
+	return millerRabin(value, witnesses64[:])
+}
+
+// millerRabin checks whether odd `value` > 2 is a strong probable prime to all `witnesses`.
+func millerRabin(value uint64, witnesses []uint64) bool {
+	d := value - 1
+	s := bits.TrailingZeros64(d)
+	d >>= s // value - 1 == d * 2 ^ s, d is odd
+	for _, w := range witnesses {
+		w %= value
+		if w == 0 { // Witness is a multiple of value, it proves nothing
+			continue
+		}
+		x := powMod64(w, d, value)
+		if x == 1 || x == value - 1 {
+			continue
+		}
+		composite := true
+		for r := 1; r < s && composite; r++ {
+			x = mulMod64(x, x, value)
+			composite = x != value - 1
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
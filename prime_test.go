@@ -0,0 +1,167 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+)
+
+// isPrimeBig checks primality of `value` with math/big, which is exact for 64-bit values.
+func isPrimeBig(value uint64) bool {
+	return new(big.Int).SetUint64(value).ProbablyPrime(0)
+}
+
+// TestIsPrimeSmall checks bit table and trial division against plain sieve.
+func TestIsPrimeSmall(t *testing.T) {
+	const limit = 1 << 20
+	composite := make([]bool, limit)
+	for value := 2; value * value < limit; value++ {
+		for multiple := value * value; multiple < limit; multiple += value {
+			composite[multiple] = true
+		}
+	}
+	for value := range limit {
+		if want := value >= 2 && !composite[value]; IsPrime(value) != want {
+			t.Fatalf("IsPrime(%d) != %v", value, want)
+		}
+	}
+	for value := -128; value < 128; value++ {
+		if want := value >= 2 && !composite[value & 0xff]; IsPrime(int8(value)) != want {
+			t.Fatalf("IsPrime(int8(%d)) != %v", value, want)
+		}
+	}
+}
+
+func TestIsPrime(t *testing.T) {
+	tests := []struct {
+		value uint64
+		prime bool
+	}{
+		// Strong pseudoprimes to several least prime bases
+		{3215031751, false}, // 151 * 751 * 28351, bases 2, 3, 5 and 7
+		{341550071728321, false}, // 10670053 * 32010157, bases up to 17
+		{3825123056546413051, false}, // 149491 * 747451 * 34233211, bases up to 23
+		// Carmichael numbers
+		{561, false}, {41041, false}, {825265, false}, {321197185, false},
+		// Around 2 ^ 32
+		{4294967291, true}, {4294967279, true}, {4294967295, false}, {4294967297, false}, {4294967311, true},
+		{65521 * 65537, false}, {4294967291 * 3, false},
+		// Around 2 ^ 64
+		{18446744073709551557, true}, {18446744073709551533, true}, {18446744073709551521, true},
+		{18446744073709551615, false}, {18446744073709551559, false}, {4294967291 * 4294967279, false},
+		{4294967291 * 4294967291, false},
+	}
+	for _, test := range tests {
+		if IsPrime(test.value) != test.prime {
+			t.Errorf("IsPrime(%d) != %v", test.value, test.prime)
+		}
+	}
+	for _, base := range []uint64{1 << 32 - 1000, 1 << 48, 1 << 64 - 1000} {
+		for value := base; value < base + 1000 && value >= base; value++ {
+			if IsPrime(value) != isPrimeBig(value) {
+				t.Fatalf("IsPrime(%d) != %v", value, !IsPrime(value))
+			}
+		}
+	}
+	if IsPrime(int64(-7)) || IsPrime(int64(-1 << 63)) {
+		t.Error("negative value is prime")
+	}
+}
+
+func TestNextPrevPrime(t *testing.T) {
+	if prime, ok := NextPrime(uint8(250)); !ok || prime != 251 {
+		t.Errorf("NextPrime(uint8(250)) == %d, %v", prime, ok)
+	}
+	if _, ok := NextPrime(uint8(251)); ok {
+		t.Error("NextPrime(uint8(251)) found a prime")
+	}
+	if prime, ok := NextPrime(int8(113)); !ok || prime != 127 {
+		t.Errorf("NextPrime(int8(113)) == %d, %v", prime, ok)
+	}
+	if _, ok := NextPrime(int8(127)); ok {
+		t.Error("NextPrime(int8(127)) found a prime")
+	}
+	if prime, ok := NextPrime(int8(-128)); !ok || prime != 2 {
+		t.Errorf("NextPrime(int8(-128)) == %d, %v", prime, ok)
+	}
+	if _, ok := NextPrime(int64(9223372036854775783)); ok { // Greatest prime below 2 ^ 63
+		t.Error("NextPrime(9223372036854775783) found a prime")
+	}
+	if prime, ok := NextPrime(uint64(18446744073709551533)); !ok || prime != 18446744073709551557 {
+		t.Errorf("NextPrime(18446744073709551533) == %d, %v", prime, ok)
+	}
+	if _, ok := NextPrime(uint64(18446744073709551557)); ok { // Greatest prime below 2 ^ 64
+		t.Error("NextPrime(18446744073709551557) found a prime")
+	}
+	if prime, ok := PrevPrime(3); !ok || prime != 2 {
+		t.Errorf("PrevPrime(3) == %d, %v", prime, ok)
+	}
+	if prime, ok := PrevPrime(uint8(5)); !ok || prime != 3 {
+		t.Errorf("PrevPrime(5) == %d, %v", prime, ok)
+	}
+	for _, value := range []int8{2, 1, 0, -1, -128} {
+		if _, ok := PrevPrime(value); ok {
+			t.Errorf("PrevPrime(%d) found a prime", value)
+		}
+	}
+	if prime, ok := PrevPrime(int8(127)); !ok || prime != 113 {
+		t.Errorf("PrevPrime(int8(127)) == %d, %v", prime, ok)
+	}
+	if prime, ok := PrevPrime(uint64(1 << 64 - 1)); !ok || prime != 18446744073709551557 {
+		t.Errorf("PrevPrime(Maximal) == %d, %v", prime, ok)
+	}
+}
+
+func TestPrimes(t *testing.T) {
+	tests := []struct {
+		from, to int8
+		primes []int8
+	}{
+		{-10, 20, []int8{2, 3, 5, 7, 11, 13, 17, 19}},
+		{-128, 3, []int8{2}},
+		{-10, 2, nil},
+		{2, 2, nil},
+		{5, -5, nil},
+		{14, 17, nil},
+		{100, 127, []int8{101, 103, 107, 109, 113}}, // Upper bound is excluded, and iteration stops at Maximal
+	}
+	for _, test := range tests {
+		if primes := slices.Collect(Primes(test.from, test.to)); !slices.Equal(primes, test.primes) {
+			t.Errorf("Primes(%d, %d) == %v, want %v", test.from, test.to, primes, test.primes)
+		}
+	}
+	if primes := slices.Collect(Primes(uint8(240), 255)); !slices.Equal(primes, []uint8{241, 251}) {
+		t.Errorf("Primes(240, 255) == %v", primes)
+	}
+	var primes []uint64
+	for prime := range Primes(uint64(0), 1 << 64 - 1) {
+		if len(primes) == 5 {
+			break
+		}
+		primes = append(primes, prime)
+	}
+	if !slices.Equal(primes, []uint64{2, 3, 5, 7, 11}) {
+		t.Errorf("first primes == %v", primes)
+	}
+	for prime := range Primes(0, 100) { // Stopping at 2, which is yielded separately
+		if prime != 2 {
+			t.Errorf("first prime == %d", prime)
+		}
+		break
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Root returns `k`-th root of `value`, rounded down.
+// Negative `value` produces negative root (rounded toward zero) for odd `k`.
+// Zero `k` and negative `value` with even `k` cause panic.
+func Root[T Integer](value T, k uint) T {
+	if k == 0 {
+		panic("imath: zero root degree")
+	}
+	if value < 0 {
+		if !IsOdd(k) {
+			panic("imath: even root of negative number")
+		}
+		return -T(root64(absu[uint64](value), k))
+	}
+	return T(root64(uint64(value), k))
+}
+
+// Cbrt returns cube root of `value`, rounded toward zero.
+func Cbrt[T Integer](value T) T {
+	return Root(value, 3)
+}
+
+// IsPerfectPower checks whether `value` is equal to `base` raised to `exponent` power,
+// where |base| >= 2 and exponent >= 2. The greatest possible `exponent` (and therefore the least |base|) is returned.
+// Values 0, 1 and -1 produce `false`.
+func IsPerfectPower[T Integer](value T) (T, uint, bool) {
+	v := absu[uint64](value)
+	if v < 4 {
+		return 0, 0, false
+	}
+	for exponent := uint(bits.Len64(v) - 1); exponent >= 2; exponent-- { // 2 ^ exponent <= v
+		if value < 0 && !IsOdd(exponent) {
+			continue
+		}
+		base := root64(v, exponent)
+		if power, _ := PowChecked(base, exponent); power == v {
+			if value < 0 {
+				return -T(base), exponent, true
+			}
+			return T(base), exponent, true
+		}
+	}
+	return 0, 0, false
+}
+
+// root64 returns `k`-th root of `value`, rounded down.
+// Floating point estimate is corrected to the exact result.
+func root64(value uint64, k uint) uint64 {
+	switch {
+	case k == 1 || value < 2:
+		return value
+	case k == 2:
+		return sqrt64(value)
+	case k >= 64:
+		return 1
+	}
+	root := uint64(math.Pow(float64(value), 1 / float64(k)))
+	for root > 1 {
+		if power, ok := PowChecked(root, k); ok && power <= value {
+			break
+		}
+		root--
+	}
+	for {
+		if power, ok := PowChecked(root + 1, k); !ok || power > value {
+			return root
+		}
+		root++
+	}
+}
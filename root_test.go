@@ -0,0 +1,94 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math/big"
+	"testing"
+)
+
+// checkRoot checks that root ^ k <= value < (root + 1) ^ k with exact arithmetic.
+func checkRoot(t *testing.T, value uint64, k uint) {
+	root := Root(value, k)
+	v, power := new(big.Int).SetUint64(value), new(big.Int)
+	exponent := big.NewInt(int64(k))
+	if power.Exp(new(big.Int).SetUint64(root), exponent, nil).Cmp(v) > 0 ||
+		power.Exp(new(big.Int).SetUint64(root + 1), exponent, nil).Cmp(v) <= 0 {
+		t.Errorf("Root(%d, %d) == %d", value, k, root)
+	}
+}
+
+func TestRootNear64(t *testing.T) {
+	for k := uint(3); k < 64; k++ {
+		checkRoot(t, 1 << 64 - 1, k)
+		root := Root(uint64(1 << 64 - 1), k)
+		power, _ := PowChecked(root, k) // The greatest k-th power in uint64 range
+		for _, value := range []uint64{power - 1, power, power + 1} {
+			checkRoot(t, value, k)
+		}
+		if root > 2 {
+			power, _ = PowChecked(root - 1, k)
+			checkRoot(t, power - 1, k)
+			checkRoot(t, power, k)
+		}
+	}
+	for _, k := range []uint{2, 64, 100} {
+		checkRoot(t, 1 << 64 - 1, k)
+	}
+	if root := Root(int64(-1 << 63), 63); root != -2 {
+		t.Errorf("Root(Minimal, 63) == %d, want -2", root)
+	}
+	if root := Cbrt(int8(-128)); root != -5 {
+		t.Errorf("Cbrt(-128) == %d, want -5", root)
+	}
+}
+
+func TestIsPerfectPower(t *testing.T) {
+	tests := []struct {
+		value, base int64
+		exponent uint
+		ok bool
+	}{
+		{-1 << 63, -2, 63, true},
+		{1 << 62, 2, 62, true},
+		{-8, -2, 3, true},
+		{-16, 0, 0, false}, // Only even powers: 4 ^ 2 and 2 ^ 4
+		{-36, 0, 0, false},
+		{-64, -4, 3, true}, // 8 ^ 2 and 2 ^ 6 are not considered for negative value
+		{1 << 63 - 1, 0, 0, false},
+		{3037000499 * 3037000499, 3037000499, 2, true}, // The greatest square in int64 range
+		{1, 0, 0, false}, {0, 0, 0, false}, {-1, 0, 0, false},
+	}
+	for _, test := range tests {
+		base, exponent, ok := IsPerfectPower(test.value)
+		if ok != test.ok || base != test.base || exponent != test.exponent {
+			t.Errorf("IsPerfectPower(%d) == %d, %d, %v, want %d, %d, %v",
+				test.value, base, exponent, ok, test.base, test.exponent, test.ok)
+		}
+	}
+	if base, exponent, ok := IsPerfectPower(int8(-128)); !ok || base != -2 || exponent != 7 {
+		t.Errorf("IsPerfectPower(int8(-128)) == %d, %d, %v", base, exponent, ok)
+	}
+	if base, exponent, ok := IsPerfectPower(uint32(3486784401)); !ok || base != 3 || exponent != 20 {
+		t.Errorf("IsPerfectPower(3486784401) == %d, %d, %v", base, exponent, ok)
+	}
+	if _, _, ok := IsPerfectPower(uint64(1 << 64 - 1)); ok {
+		t.Error("IsPerfectPower(Maximal) is true")
+	}
+	if base, exponent, ok := IsPerfectPower(uint64(1 << 63)); !ok || base != 2 || exponent != 63 {
+		t.Errorf("IsPerfectPower(2 ^ 63) == %d, %d, %v", base, exponent, ok)
+	}
+}
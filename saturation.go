@@ -0,0 +1,71 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+// Saturating functions clamp results to [Minimal, Maximal] range instead of wrapping around.
+
+func AbsSat[T Signed](value T) T {
+	if value == Minimal[T]() {
+		return Maximal[T]()
+	}
+	return Abs(value)
+}
+
+func AddSat[T Integer](value_0, value_1 T) T {
+	sum, ok := addChecked(value_0, value_1)
+	if !ok {
+		return saturate[T](value_1 < 0)
+	}
+	return sum
+}
+
+func SubSat[T Integer](minuend, subtrahend T) T {
+	difference, ok := subChecked(minuend, subtrahend)
+	if !ok {
+		return saturate[T](subtrahend > 0)
+	}
+	return difference
+}
+
+func MulSat[T Integer](value_0, value_1 T) T {
+	product, ok := mulChecked(value_0, value_1)
+	if !ok {
+		return saturate[T]((value_0 < 0) != (value_1 < 0))
+	}
+	return product
+}
+
+// NegSat returns -value, clamped to Maximal for signed Minimal and to 0 for any unsigned value.
+func NegSat[T Integer](value T) T {
+	return SubSat(0, value)
+}
+
+// PowSat raises `base` to `exponent` power like Pow, but clamps the result on overflow.
+func PowSat[T Integer](base T, exponent uint) T {
+	power, ok := PowChecked(base, exponent)
+	if !ok {
+		return saturate[T](base < 0 && IsOdd(exponent))
+	}
+	return power
+}
+
+// saturate returns the bound an overflowed result is clamped to: Minimal if `negative`, otherwise Maximal.
+func saturate[T Integer](negative bool) T {
+	if negative {
+		return Minimal[T]()
+	}
+	return Maximal[T]()
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math/big"
+	"testing"
+)
+
+// clamp returns `value` clamped to [Minimal, Maximal] range of T.
+func clamp[T Integer](value int) T {
+	return T(min(max(value, int(Minimal[T]())), int(Maximal[T]())))
+}
+
+// TestSatExhaustive checks saturating functions against clamped int arithmetic for all 8-bit pairs.
+func TestSatExhaustive(t *testing.T) {
+	for value_0 := -128; value_0 < 128; value_0++ {
+		v0 := int8(value_0)
+		if neg := NegSat(v0); neg != clamp[int8](-value_0) {
+			t.Fatalf("NegSat(%d) == %d", value_0, neg)
+		}
+		if abs := AbsSat(v0); abs != clamp[int8](max(value_0, -value_0)) {
+			t.Fatalf("AbsSat(%d) == %d", value_0, abs)
+		}
+		for value_1 := -128; value_1 < 128; value_1++ {
+			v1 := int8(value_1)
+			if sum := AddSat(v0, v1); sum != clamp[int8](value_0 + value_1) {
+				t.Fatalf("AddSat(%d, %d) == %d", value_0, value_1, sum)
+			}
+			if difference := SubSat(v0, v1); difference != clamp[int8](value_0 - value_1) {
+				t.Fatalf("SubSat(%d, %d) == %d", value_0, value_1, difference)
+			}
+			if product := MulSat(v0, v1); product != clamp[int8](value_0 * value_1) {
+				t.Fatalf("MulSat(%d, %d) == %d", value_0, value_1, product)
+			}
+		}
+	}
+	for value_0 := range 256 {
+		v0 := uint8(value_0)
+		if neg := NegSat(v0); neg != 0 {
+			t.Fatalf("NegSat(uint8(%d)) == %d", value_0, neg)
+		}
+		for value_1 := range 256 {
+			v1 := uint8(value_1)
+			if sum := AddSat(v0, v1); sum != clamp[uint8](value_0 + value_1) {
+				t.Fatalf("AddSat(uint8(%d), %d) == %d", value_0, value_1, sum)
+			}
+			if difference := SubSat(v0, v1); difference != clamp[uint8](value_0 - value_1) {
+				t.Fatalf("SubSat(uint8(%d), %d) == %d", value_0, value_1, difference)
+			}
+			if product := MulSat(v0, v1); product != clamp[uint8](value_0 * value_1) {
+				t.Fatalf("MulSat(uint8(%d), %d) == %d", value_0, value_1, product)
+			}
+		}
+	}
+}
+
+func TestPowSat(t *testing.T) {
+	minimal, maximal := big.NewInt(-128), big.NewInt(127)
+	for base := -128; base < 128; base++ {
+		for exponent := range uint(20) {
+			power := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(exponent)), nil)
+			if power.Cmp(minimal) < 0 {
+				power = minimal
+			} else if power.Cmp(maximal) > 0 {
+				power = maximal
+			}
+			if p := PowSat(int8(base), exponent); int64(p) != power.Int64() {
+				t.Fatalf("PowSat(%d, %d) == %d, want %v", base, exponent, p, power)
+			}
+		}
+	}
+	if power := PowSat(uint64(10), 20); power != 1 << 64 - 1 {
+		t.Errorf("PowSat(10, 20) == %d, want Maximal", power)
+	}
+	if power := PowSat(int64(-10), 19); power != -1 << 63 {
+		t.Errorf("PowSat(-10, 19) == %d, want Minimal", power)
+	}
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+// Package sieve implements segmented Sieve of Eratosthenes for enumerating and counting primes in 64-bit ranges.
+package sieve
+
+import (
+	"iter"
+	"math"
+	"math/bits"
+
+	"github.com/adam-lavrik/go-imath"
+)
+
+// errLimit is a panic message for ranges beyond sieve limit.
+const errLimit = "sieve: range exceeds sieve limit"
+
+// segmentBits is a count of odd numbers sieved at once (32 KiB of bitmap).
+const segmentBits = 1 << 18
+
+// presievePrimes are the least odd primes, whose multiples are marked by copying periodic pattern instead of sieving.
+var presievePrimes = [...]uint64{3, 5, 7, 11, 13}
+
+// presievePeriod is a period of pattern in odd numbers (product of presievePrimes).
+const presievePeriod = 3 * 5 * 7 * 11 * 13
+
+// presieve is a pattern of odd multiples of presievePrimes, where bit i stands for 2 * i + 1.
+// It is longer than period by two words, so any 64 bits starting within period can be read at once.
+var presieve = func() []uint64 {
+	pattern := make([]uint64, presievePeriod / 64 + 2)
+	for index := range len(pattern) * 64 {
+		for _, prime := range presievePrimes {
+			if (2 * uint64(index) + 1) % prime == 0 {
+				pattern[index >> 6] |= 1 << (index & 63)
+			}
+		}
+	}
+	return pattern
+}()
+
+// Sieve holds odd base primes up to square root of its limit.
+// It is never modified after construction, so it can be shared across goroutines,
+// each call sieving in its own bounded buffer.
+type Sieve struct {
+	limit uint64 // Exclusive upper bound of sieved numbers
+	primes []uint32 // Odd primes up to square root of (limit - 1)
+}
+
+// New creates sieve for numbers less than `limit`.
+// Memory used by the sieve is proportional to count of primes up to square root of `limit`.
+func New(limit uint64) *Sieve {
+	sieve := &Sieve{limit: limit}
+	if limit > 9 {
+		root := imath.Sqrt(limit - 1)
+		sieve.primes = make([]uint32, 0, primePiBound(root))
+		boot := &Sieve{limit: root + 1, primes: smallPrimes(uint32(imath.Sqrt(root)))}
+		boot.segments(3, root + 1, func(base uint64, composite []uint64, count int) bool {
+			each(base, composite, count, func(prime uint64) bool {
+				sieve.primes = append(sieve.primes, uint32(prime))
+				return true
+			})
+			return true
+		})
+	}
+	return sieve
+}
+
+// Limit returns exclusive upper bound of numbers covered by sieve.
+func (sieve *Sieve) Limit() uint64 {
+	return sieve.limit
+}
+
+// Primes returns iterator over prime numbers in [lo, hi) range in ascending order.
+// Each segment of the range costs O(pi(sqrt(hi))), so even short ranges near 2 ^ 64 take seconds.
+// Panics if `hi` exceeds sieve limit.
+func (sieve *Sieve) Primes(lo, hi uint64) iter.Seq[uint64] {
+	sieve.check(hi)
+	return func(yield func(uint64) bool) {
+		if lo <= 2 && hi > 2 && !yield(2) {
+			return
+		}
+		sieve.segments(lo, hi, func(base uint64, composite []uint64, count int) bool {
+			return each(base, composite, count, yield)
+		})
+	}
+}
+
+// Count returns count of prime numbers in [lo, hi) range. Its cost is the same as of Primes.
+// Panics if `hi` exceeds sieve limit.
+func (sieve *Sieve) Count(lo, hi uint64) uint64 {
+	sieve.check(hi)
+	count := uint64(0)
+	if lo <= 2 && hi > 2 {
+		count = 1
+	}
+	sieve.segments(lo, hi, func(base uint64, composite []uint64, n int) bool {
+		count += countPrimes(composite, n)
+		return true
+	})
+	return count
+}
+
+// PrimePi returns count of prime numbers not greater than `n`.
+// Panics if `n` is not less than sieve limit.
+func (sieve *Sieve) PrimePi(n uint64) uint64 {
+	if n >= sieve.limit {
+		panic(errLimit)
+	}
+	return sieve.Count(0, n + 1)
+}
+
+// NthPrime returns `k`-th prime number (counting from 1, so NthPrime(1) == 2) and `true`.
+// If `k` is 0 or the prime is not less than sieve limit, second result is `false`.
+func (sieve *Sieve) NthPrime(k uint64) (uint64, bool) {
+	if k == 0 || sieve.limit <= 2 {
+		return 0, false
+	}
+	if k == 1 {
+		return 2, true
+	}
+	k-- // Prime 2 is already counted
+	prime := uint64(0)
+	sieve.segments(3, sieve.limit, func(base uint64, composite []uint64, count int) bool {
+		if n := countPrimes(composite, count); n < k { // Skip the whole segment
+			k -= n
+			return true
+		}
+		each(base, composite, count, func(value uint64) bool {
+			if k--; k == 0 {
+				prime = value
+			}
+			return k != 0
+		})
+		return false
+	})
+	return prime, prime != 0
+}
+
+// check panics if range bound `hi` exceeds sieve limit.
+func (sieve *Sieve) check(hi uint64) {
+	if hi > sieve.limit {
+		panic(errLimit)
+	}
+}
+
+// segments sieves odd numbers in [lo, hi) range segment by segment, passing to `visit` base (the first odd number of segment),
+// bitmap of composite numbers (bit i stands for base + 2 * i) and count of odd numbers in segment.
+// Bits beyond the count are zero. Stops when `visit` returns `false`.
+// Every segment loops over all base primes up to square root of its last number, so it costs O(pi(sqrt(hi)))
+// besides marking multiples: near 2 ^ 64 these are about 2 * 10 ^ 8 primes per 2 ^ 19 numbers, most of which do not hit the segment.
+func (sieve *Sieve) segments(lo, hi uint64, visit func(base uint64, composite []uint64, count int) bool) {
+	if lo < 3 {
+		lo = 3
+	} else {
+		lo |= 1
+	}
+	if lo >= hi {
+		return
+	}
+	buffer := make([]uint64, segmentBits / 64)
+	for base, remaining := lo, (hi - lo + 1) / 2; remaining > 0; base += 2 * segmentBits {
+		count := int(min(remaining, segmentBits))
+		composite := buffer[:(count + 63) / 64]
+		last := base + 2 * uint64(count - 1)
+		position := int(base / 2 % presievePeriod) // Position of base in presieve pattern
+		for index := range composite {
+			shift := position & 63
+			composite[index] = presieve[position >> 6] >> shift | presieve[position >> 6 + 1] << (64 - shift)
+			if position += 64; position >= presievePeriod {
+				position -= presievePeriod
+			}
+		}
+		if tail := count & 63; tail != 0 {
+			composite[len(composite) - 1] &= 1 << tail - 1
+		}
+		for _, prime := range presievePrimes { // Presieve marks primes themselves as composite
+			if prime >= base && prime <= last {
+				index := (prime - base) / 2
+				composite[index >> 6] &^= 1 << (index & 63)
+			}
+		}
+		for _, prime := range sieve.primes[min(len(presievePrimes), len(sieve.primes)):] {
+			step := uint64(prime)
+			square := step * step
+			if square > last {
+				break
+			}
+			var offset uint64 // Offset of the first odd multiple of prime (not less than its square) from base
+			if square >= base {
+				offset = square - base
+			} else {
+				offset = (step - base % step) % step
+				if offset & 1 != 0 { // Multiple is even
+					offset += step
+				}
+			}
+			for index := offset / 2; index < uint64(count); index += step {
+				composite[index >> 6] |= 1 << (index & 63)
+			}
+		}
+		if !visit(base, composite, count) {
+			return
+		}
+		remaining -= uint64(count)
+	}
+}
+
+// countPrimes returns count of primes in a sieved segment of `count` odd numbers.
+func countPrimes(composite []uint64, count int) uint64 {
+	n := uint64(count)
+	for _, word := range composite {
+		n -= uint64(bits.OnesCount64(word))
+	}
+	return n
+}
+
+// each calls `yield` for each prime of a sieved segment. Returns `false` if `yield` stopped iteration.
+func each(base uint64, composite []uint64, count int, yield func(uint64) bool) bool {
+	for index, word := range composite {
+		word = ^word
+		if tail := count - index * 64; tail < 64 {
+			word &= 1 << tail - 1
+		}
+		for ; word != 0; word &= word - 1 {
+			if !yield(base + 2 * uint64(index * 64 + bits.TrailingZeros64(word))) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// primePiBound returns upper bound of count of primes not greater than `n` > 1
+// (Dusart: pi(x) <= x / ln(x) * (1 + 1.2762 / ln(x)), exceeding the count by less than 1% for n around 2 ^ 32).
+func primePiBound(n uint64) int {
+	log := math.Log(float64(n))
+	return int(float64(n) / log * (1 + 1.2762 / log)) + 1
+}
+
+// smallPrimes returns odd primes up to `n` using plain bit-packed sieve.
+func smallPrimes(n uint32) []uint32 {
+	var primes []uint32
+	composite := make([]uint64, n / 128 + 1) // Bit i stands for 2 * i + 1
+	for value := uint32(3); value <= n; value += 2 {
+		index := value / 2
+		if composite[index >> 6] & (1 << (index & 63)) != 0 {
+			continue
+		}
+		primes = append(primes, value)
+		for multiple := value * value / 2; multiple <= n / 2; multiple += value {
+			composite[multiple >> 6] |= 1 << (multiple & 63)
+		}
+	}
+	return primes
+}
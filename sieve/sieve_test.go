@@ -0,0 +1,195 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package sieve
+
+import (
+	"os"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/adam-lavrik/go-imath"
+)
+
+// primesIn returns primes in [lo, hi) range, checked by imath.IsPrime.
+func primesIn(lo, hi uint64) []uint64 {
+	var primes []uint64
+	for value := lo; value < hi && value >= lo; value++ {
+		if imath.IsPrime(value) {
+			primes = append(primes, value)
+		}
+	}
+	return primes
+}
+
+// checkRange checks Primes and Count of `sieve` for [lo, hi) range.
+// Expected primes are taken from sorted `reference` primes, if it is not nil.
+func checkRange(t *testing.T, sieve *Sieve, reference []uint64, lo, hi uint64) {
+	t.Helper()
+	var want []uint64
+	if reference == nil {
+		want = primesIn(lo, hi)
+	} else if lo < hi {
+		from, _ := slices.BinarySearch(reference, lo)
+		to, _ := slices.BinarySearch(reference, hi)
+		want = reference[from:to]
+	}
+	if primes := slices.Collect(sieve.Primes(lo, hi)); !slices.Equal(primes, want) {
+		t.Fatalf("New(%d).Primes(%d, %d) == %v, want %v", sieve.Limit(), lo, hi, primes, want)
+	}
+	if count := sieve.Count(lo, hi); count != uint64(len(want)) {
+		t.Fatalf("New(%d).Count(%d, %d) == %d, want %d", sieve.Limit(), lo, hi, count, len(want))
+	}
+}
+
+func TestSmallLimits(t *testing.T) {
+	for limit := range uint64(300) {
+		sieve := New(limit)
+		for lo := range limit + 1 {
+			checkRange(t, sieve, nil, lo, limit)
+			checkRange(t, sieve, nil, 0, lo)
+		}
+		for n := range limit {
+			if pi, want := sieve.PrimePi(n), uint64(len(primesIn(0, n + 1))); pi != want {
+				t.Fatalf("New(%d).PrimePi(%d) == %d, want %d", limit, n, pi, want)
+			}
+		}
+		primes := primesIn(0, limit)
+		for k := range uint64(len(primes)) + 2 {
+			prime, ok := sieve.NthPrime(k)
+			if want := k > 0 && k <= uint64(len(primes)); ok != want || ok && prime != primes[k - 1] {
+				t.Fatalf("New(%d).NthPrime(%d) == %d, %v", limit, k, prime, ok)
+			}
+		}
+	}
+}
+
+// TestBoundaries checks ranges around segment and presieve pattern boundaries.
+func TestBoundaries(t *testing.T) {
+	sieve := New(8 * segmentBits + 1)
+	reference := primesIn(0, sieve.Limit())
+	for _, bound := range []uint64{2 * segmentBits, 4 * segmentBits, 2 * presievePeriod, 2 * presievePeriod * 64, sieve.Limit()} {
+		for _, delta := range []uint64{0, 1, 2, 3, 63, 64, 65, 127, 128} {
+			checkRange(t, sieve, reference, bound - delta, min(bound + 200, sieve.Limit()))
+			checkRange(t, sieve, reference, 0, bound - delta)
+			checkRange(t, sieve, reference, 3 + 2 * segmentBits - delta, 3 + 2 * segmentBits + delta)
+			if bound + delta <= sieve.Limit() {
+				checkRange(t, sieve, reference, bound - 200, bound + delta)
+			}
+		}
+	}
+	// Segment is 2 * segmentBits numbers long, so k-th primes around the end of the first segments cross boundaries
+	primes := primesIn(0, 6 * segmentBits)
+	for k := uint64(len(primes)) - 3 * 64; k <= uint64(len(primes)); k++ {
+		if prime, ok := sieve.NthPrime(k); !ok || prime != primes[k - 1] {
+			t.Fatalf("NthPrime(%d) == %d, %v, want %d", k, prime, ok, primes[k - 1])
+		}
+	}
+}
+
+func TestKnownCounts(t *testing.T) {
+	sieve := New(1e9 + 1)
+	tests := []struct {
+		n, pi uint64
+	}{
+		{10, 4}, {100, 25}, {1000, 168}, {10000, 1229}, {1e5, 9592}, {1e6, 78498}, {1e7, 664579}, {1e8, 5761455}, {1e9, 50847534},
+	}
+	for _, test := range tests {
+		if pi := sieve.PrimePi(test.n); pi != test.pi {
+			t.Errorf("PrimePi(%d) == %d, want %d", test.n, pi, test.pi)
+		}
+	}
+	if prime, ok := sieve.NthPrime(1e6); !ok || prime != 15485863 {
+		t.Errorf("NthPrime(1e6) == %d, %v", prime, ok)
+	}
+	if prime, ok := sieve.NthPrime(50847534); !ok || prime != 999999937 {
+		t.Errorf("NthPrime(50847534) == %d, %v", prime, ok)
+	}
+	if _, ok := sieve.NthPrime(50847535); ok {
+		t.Error("NthPrime beyond limit succeeded")
+	}
+}
+
+// TestNear48 checks windows near 2 ^ 48, where all base primes below 2 ^ 24 take part in sieving.
+func TestNear48(t *testing.T) {
+	sieve := New(1 << 48)
+	checkRange(t, sieve, nil, 1 << 48 - 3000, 1 << 48)
+	checkRange(t, sieve, nil, 1 << 47 - 1000, 1 << 47 + 1000)
+	checkRange(t, sieve, nil, 1 << 48 - 2 * segmentBits - 500, 1 << 48 - 2 * segmentBits + 500) // Across segment boundary
+	if prime, ok := imath.PrevPrime(uint64(1 << 48)); !ok || slices.Collect(sieve.Primes(prime, 1 << 48))[0] != prime {
+		t.Errorf("the greatest prime below 2 ^ 48 is not found")
+	}
+}
+
+// TestNear64 checks windows near 2 ^ 64, where the sieve needs all primes below 2 ^ 32.
+// It takes about 820 MB and 20 seconds, so it runs only if IMATH_SIEVE_64 environment variable is set.
+func TestNear64(t *testing.T) {
+	if os.Getenv("IMATH_SIEVE_64") == "" {
+		t.Skip("set IMATH_SIEVE_64=1 to run: sieve for the whole 64-bit range takes about 820 MB")
+	}
+	sieve := New(1 << 64 - 1)
+	checkRange(t, sieve, nil, 1 << 64 - 2000, 1 << 64 - 1)
+	if primes := slices.Collect(sieve.Primes(1 << 64 - 100, 1 << 64 - 1)); !slices.Equal(primes, []uint64{1 << 64 - 95, 1 << 64 - 83, 1 << 64 - 59}) {
+		t.Errorf("Primes near 2 ^ 64 == %v", primes)
+	}
+}
+
+// TestPrimePiBound checks that capacity of base primes is never less than their count.
+func TestPrimePiBound(t *testing.T) {
+	count := 0
+	for prime := range New(1 << 26).Primes(0, 1 << 26) { // Bound is checked where count increases; it grows for n > 4 and is far above count for less n
+		if count++; primePiBound(prime) < count {
+			t.Fatalf("primePiBound(%d) == %d, less than %d", prime, primePiBound(prime), count)
+		}
+	}
+	for _, limit := range []uint64{10, 1000, 1 << 40} {
+		if sieve := New(limit); len(sieve.primes) > primePiBound(imath.Sqrt(limit - 1)) || cap(sieve.primes) != primePiBound(imath.Sqrt(limit - 1)) {
+			t.Errorf("New(%d) has %d base primes with capacity %d", limit, len(sieve.primes), cap(sieve.primes))
+		}
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	sieve := New(1e7 + 1)
+	var group sync.WaitGroup
+	for range 8 {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			if pi := sieve.PrimePi(1e7); pi != 664579 {
+				t.Errorf("PrimePi(1e7) == %d", pi)
+			}
+		}()
+	}
+	group.Wait()
+}
+
+func TestLimitPanics(t *testing.T) {
+	for _, call := range []func(sieve *Sieve){
+		func(sieve *Sieve) { sieve.PrimePi(100) },
+		func(sieve *Sieve) { sieve.Count(0, 101) },
+		func(sieve *Sieve) { sieve.Primes(0, 101) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("range beyond limit did not panic")
+				}
+			}()
+			call(New(100))
+		}()
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "math"
+
+// Sqrt returns square root of `value`, rounded down.
+// Result is exact for the whole range of T (no floating point rounding errors).
+// Negative `value` causes panic.
+func Sqrt[T Integer](value T) T {
+	return T(sqrt64(nonNegative(value)))
+}
+
+// SqrtCeil returns square root of `value`, rounded up.
+// Negative `value` causes panic.
+func SqrtCeil[T Integer](value T) T {
+	v := nonNegative(value)
+	root := sqrt64(v)
+	if root * root != v {
+		root++
+	}
+	return T(root)
+}
+
+// SqrtRem returns square root of `value`, rounded down, and remainder value - root * root.
+// Negative `value` causes panic.
+func SqrtRem[T Integer](value T) (T, T) {
+	v := nonNegative(value)
+	root := sqrt64(v)
+	return T(root), T(v - root * root)
+}
+
+// SqrtChecked returns square root of `value`, rounded down, and `true`.
+// Negative `value` produces `false` instead of panic.
+func SqrtChecked[T Integer](value T) (T, bool) {
+	if value < 0 {
+		return 0, false
+	}
+	return T(sqrt64(uint64(value))), true
+}
+
+// SqrtErr is the same as SqrtChecked, but negative `value` produces ErrNegative.
+func SqrtErr[T Integer](value T) (T, error) {
+	if value < 0 {
+		return 0, ErrNegative
+	}
+	return T(sqrt64(uint64(value))), nil
+}
+
+// IsSquare checks whether `value` is a square of integer. Negative values always produce `false`.
+func IsSquare[T Integer](value T) bool {
+	if value < 0 {
+		return false
+	}
+	root := sqrt64(uint64(value))
+	return root * root == uint64(value)
+}
+
+func nonNegative[T Integer](value T) uint64 {
+	if value < 0 {
+		panic("imath: square root of negative number")
+	}
+	return uint64(value)
+}
+
+// sqrt64 returns square root of `value`, rounded down.
+// Floating point estimate is corrected to the exact result.
+func sqrt64(value uint64) uint64 {
+	const maximal = 1 << 32 - 1 // Maximal root, which square fits into uint64
+	root := min(uint64(math.Sqrt(float64(value))), maximal)
+	for root * root > value {
+		root--
+	}
+	for root < maximal && (root + 1) * (root + 1) <= value {
+		root++
+	}
+	return root
+}
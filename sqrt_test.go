@@ -0,0 +1,72 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "testing"
+
+func TestSqrt(t *testing.T) {
+	tests := []struct {
+		value, root uint64
+	}{
+		{0, 0}, {1, 1}, {3, 1}, {4, 2}, {255, 15}, {256, 16},
+		{1 << 52 + 1, 1 << 26}, {(1 << 32 - 1) * (1 << 32 - 1), 1 << 32 - 1}, {1 << 64 - 1, 1 << 32 - 1},
+	}
+	for _, test := range tests {
+		if root := Sqrt(test.value); root != test.root {
+			t.Errorf("Sqrt(%d) == %d, want %d", test.value, root, test.root)
+		}
+		if root, rem := SqrtRem(test.value); root != test.root || rem != test.value - root * root {
+			t.Errorf("SqrtRem(%d) == %d, %d", test.value, root, rem)
+		}
+		square, ceil := test.root * test.root == test.value, test.root + 1
+		if square {
+			ceil = test.root
+		}
+		if root := SqrtCeil(test.value); root != ceil {
+			t.Errorf("SqrtCeil(%d) == %d, want %d", test.value, root, ceil)
+		}
+		if IsSquare(test.value) != square {
+			t.Errorf("IsSquare(%d) != %v", test.value, square)
+		}
+	}
+}
+
+func TestSqrtNegative(t *testing.T) {
+	if root, ok := SqrtChecked(int8(-1)); ok || root != 0 {
+		t.Errorf("SqrtChecked(-1) == %d, %v", root, ok)
+	}
+	if root, ok := SqrtChecked(int64(-1 << 63)); ok || root != 0 {
+		t.Errorf("SqrtChecked(Minimal) == %d, %v", root, ok)
+	}
+	if root, ok := SqrtChecked(int32(99)); !ok || root != 9 {
+		t.Errorf("SqrtChecked(99) == %d, %v", root, ok)
+	}
+	if IsSquare(int8(-4)) || IsSquare(int64(-1 << 63)) || !IsSquare(int8(0)) {
+		t.Error("IsSquare(-4), IsSquare(Minimal) or IsSquare(0) is wrong")
+	}
+	if _, err := SqrtErr(-4); err != ErrNegative {
+		t.Errorf("SqrtErr(-4) error == %v, want ErrNegative", err)
+	}
+	if root, err := SqrtErr(uint16(65535)); err != nil || root != 255 {
+		t.Errorf("SqrtErr(65535) == %d, %v", root, err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Sqrt(-1) did not panic")
+		}
+	}()
+	Sqrt(-1)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import (
+	"math/bits"
+	"slices"
+)
+
+// Sum returns sum of slice items. Overflow wraps around like built-in addition does.
+// Empty slice produces 0.
+func Sum[T Integer](values []T) T {
+	sum := T(0)
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+// SumChecked returns sum of slice items and `true` if it fits into T, otherwise it returns `false`.
+// Intermediate sums never overflow, so only the final result matters.
+func SumChecked[T Integer](values []T) (T, bool) {
+	hi, lo := sum128(values)
+	return T(lo), fits128[T](hi, lo)
+}
+
+// SumErr returns sum of slice items like Sum, but reports ErrOverflow if the exact sum does not fit into T.
+func SumErr[T Integer](values []T) (T, error) {
+	sum, ok := SumChecked(values)
+	if !ok {
+		return 0, ErrOverflow
+	}
+	return sum, nil
+}
+
+// SumWide returns sum of slice items as 128-bit signed integer: high (`hi`) and low (`lo`) 64-bit halves.
+// It never overflows.
+func SumWide[T Integer](values []T) (int64, uint64) {
+	hi, lo := sum128(values)
+	return int64(hi), lo
+}
+
+// Product returns product of slice items. Overflow wraps around like built-in multiplication does.
+// Empty slice produces 1.
+func Product[T Integer](values []T) T {
+	product := T(1)
+	for _, v := range values {
+		product *= v
+	}
+	return product
+}
+
+// ProductChecked returns product of slice items and `true` if it fits into T, otherwise it returns `false`.
+// Since absolute value of product never decreases (unless some item is zero), calculation stops at the first overflow.
+func ProductChecked[T Integer](values []T) (T, bool) {
+	if slices.Contains(values, 0) { // Zero result fits whatever other items are
+		return 0, true
+	}
+	limit := absu[uint64](Minimal[T]()) // Maximal absolute value of negative product
+	if !IsSigned[T]() {
+		limit = uint64(Maximal[T]())
+	}
+	magnitude, negative := uint64(1), false
+	for _, v := range values {
+		hi, lo := bits.Mul64(magnitude, absu[uint64](v))
+		if hi != 0 || lo > limit {
+			return 0, false
+		}
+		magnitude, negative = lo, negative != (v < 0)
+	}
+	if negative {
+		return T(-magnitude), true
+	}
+	if magnitude > uint64(Maximal[T]()) {
+		return 0, false
+	}
+	return T(magnitude), true
+}
+
+// ProductErr returns product of slice items like Product, but reports ErrOverflow if the exact product does not fit into T.
+func ProductErr[T Integer](values []T) (T, error) {
+	product, ok := ProductChecked(values)
+	if !ok {
+		return 0, ErrOverflow
+	}
+	return product, nil
+}
+
+// fits128 checks whether 128-bit two's complement integer (hi, lo) fits into T.
+func fits128[T Integer](hi, lo uint64) bool {
+	if IsSigned[T]() {
+		return hi == uint64(int64(lo) >> 63) && int64(T(lo)) == int64(lo)
+	}
+	return hi == 0 && uint64(T(lo)) == lo
+}
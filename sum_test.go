@@ -0,0 +1,86 @@
+/*
+Copyright 2020...2021 Adam Lavrik <lavrik.adam@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing,
+software distributed under the License is distributed on
+an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+package imath
+
+import "testing"
+
+func TestChecked(t *testing.T) {
+	if _, ok := SumChecked([]uint8{200, 100}); ok {
+		t.Error("SumChecked(200, 100) fits")
+	}
+	if sum, ok := SumChecked([]int8{127, 1, -2}); !ok || sum != 126 {
+		t.Errorf("SumChecked(127, 1, -2) == %d, %v", sum, ok)
+	}
+	if sum, ok := SumChecked([]int64{}); !ok || sum != 0 {
+		t.Errorf("SumChecked() == %d, %v", sum, ok)
+	}
+	if product, ok := ProductChecked([]int16{-2, 8, 2048}); !ok || product != -32768 {
+		t.Errorf("ProductChecked(-2, 8, 2048) == %d, %v", product, ok)
+	}
+	if _, ok := ProductChecked([]int16{2, 8, 2048}); ok {
+		t.Error("ProductChecked(2, 8, 2048) fits")
+	}
+	if product, ok := ProductChecked([]uint64{1 << 32, 1 << 32, 0}); !ok || product != 0 {
+		t.Errorf("ProductChecked(2 ^ 32, 2 ^ 32, 0) == %d, %v", product, ok)
+	}
+	if product, ok := ProductChecked([]uint32{}); !ok || product != 1 {
+		t.Errorf("ProductChecked() == %d, %v", product, ok)
+	}
+}
+
+func TestSumProductErr(t *testing.T) {
+	if _, err := SumErr([]int8{-128, -1}); err != ErrOverflow {
+		t.Errorf("SumErr(-128, -1) error == %v, want ErrOverflow", err)
+	}
+	if sum, err := SumErr([]uint16{65535, 1, 0}); err != ErrOverflow || sum != 0 {
+		t.Errorf("SumErr(65535, 1, 0) == %d, %v", sum, err)
+	}
+	if sum, err := SumErr([]int32{-1 << 31, 1 << 31 - 1}); err != nil || sum != -1 {
+		t.Errorf("SumErr(Minimal, Maximal) == %d, %v", sum, err)
+	}
+	if _, err := ProductErr([]uint32{1 << 16, 1 << 16}); err != ErrOverflow {
+		t.Errorf("ProductErr(2 ^ 16, 2 ^ 16) error == %v, want ErrOverflow", err)
+	}
+	if product, err := ProductErr([]int8{-2, 64}); err != nil || product != -128 {
+		t.Errorf("ProductErr(-2, 64) == %d, %v", product, err)
+	}
+}
+
+func TestSumWide(t *testing.T) {
+	tests := []struct {
+		values []int64
+		hi int64
+		lo uint64
+	}{
+		{[]int64{-1}, -1, 1 << 64 - 1},
+		{[]int64{-1 << 63, -1 << 63}, -1, 0},
+		{[]int64{-1 << 63, -1 << 63, -1}, -2, 1 << 64 - 1},
+		{[]int64{1 << 63 - 1, 1 << 63 - 1, 2}, 1, 0},
+		{[]int64{1 << 63 - 1, -1 << 63}, -1, 1 << 64 - 1},
+		{[]int64{}, 0, 0},
+	}
+	for _, test := range tests {
+		if hi, lo := SumWide(test.values); hi != test.hi || lo != test.lo {
+			t.Errorf("SumWide(%v) == %d, %d, want %d, %d", test.values, hi, lo, test.hi, test.lo)
+		}
+	}
+	if hi, lo := SumWide([]uint64{1 << 64 - 1, 1}); hi != 1 || lo != 0 {
+		t.Errorf("SumWide(Maximal, 1) == %d, %d, want 1, 0", hi, lo)
+	}
+	if hi, lo := SumWide([]uint64{1 << 64 - 1, 1 << 64 - 1, 1 << 64 - 1}); hi != 2 || lo != 1 << 64 - 3 {
+		t.Errorf("SumWide(Maximal, Maximal, Maximal) == %d, %d, want 2, Maximal - 2", hi, lo)
+	}
+}
@@ -15,6 +15,8 @@ language governing permissions and limitations under the License.
 */
 package u16
 
+import "github.com/adam-lavrik/go-imath"
+
 type T = uint16
 type ST = int16
 
@@ -25,119 +27,150 @@ const (
 	Maximal = ^Minimal
 )
 
+func AbsDiff(value_0, value_1 T) T {
+	return imath.AbsDiff[T](value_0, value_1)
+}
+
+func AddSat(value_0, value_1 T) T {
+	return imath.AddSat(value_0, value_1)
+}
+
+func CRT(residues, moduli []T) (T, T, bool) {
+	return imath.CRT(residues, moduli)
+}
+
 func DivMod(dividend, divisor T) (T, T) {
-	return dividend / divisor, dividend % divisor
+	return imath.DivMod(dividend, divisor)
+}
+
+func DivModErr(dividend, divisor T) (T, T, error) {
+	return imath.DivModErr(dividend, divisor)
+}
+
+func ExtGCD(value_0, value_1 T) (T, ST, ST) {
+	return imath.ExtGCD[T, ST](value_0, value_1)
 }
 
 func GCD(value_0, value_1 T) T {
-	for value_1 != 0 {
-		value_0, value_1 = value_1, value_0 % value_1
-	}
-	return value_0
+	return imath.GCD[T](value_0, value_1)
+}
+
+func GCDs(value T, values ...T) T {
+	return imath.GCDs[T](value, values...)
+}
+
+func GCDSlice(values []T) T {
+	return imath.GCDSlice[T](values)
 }
 
 func Is2Power(value T) bool {
-	return value != 0 && (value & (value - 1) == 0)
+	return imath.Is2Power(value)
 }
 
 func IsOdd(value T) bool {
-	return (value & 1) != 0
+	return imath.IsOdd(value)
 }
 
 func LCM(value_0, value_1 T) T {
-	if value_0 == 0 || value_1 == 0 {
-		return 0
-	}
-	return value_0 / GCD(value_0, value_1) * value_1
+	return imath.LCM[T](value_0, value_1)
+}
+
+func LCMChecked(value_0, value_1 T) (T, bool) {
+	return imath.LCMChecked[T](value_0, value_1)
+}
+
+func LCMErr(value_0, value_1 T) (T, error) {
+	return imath.LCMErr[T](value_0, value_1)
+}
+
+func LCMs(value T, values ...T) T {
+	return imath.LCMs[T](value, values...)
+}
+
+func LCMSlice(values []T) T {
+	return imath.LCMSlice[T](values)
+}
+
+func LCMSliceChecked(values []T) (T, bool) {
+	return imath.LCMSliceChecked[T](values)
 }
 
 func Min(value_0, value_1 T) T {
-	if value_0 < value_1 {
-		return value_0
-	}
-	return value_1
+	return imath.Min(value_0, value_1)
+}
+
+func Mins(value T, values ...T) T {
+	return imath.Mins(value, values...)
 }
 
 func MinSlice(values []T) T {
-	return Mins(values[0], values[1:]...)
+	return imath.MinSlice(values)
 }
 
 func MinSliceChecked(values []T) (T, bool) {
-	if len(values) == 0 {
-		return 0, true
-	}
-	return MinSlice(values), false
+	return imath.MinSliceChecked(values)
 }
 
-func Mins(value T, values ...T) T {
-	for _, v := range values {
-		if v < value {
-			value = v
-		}
-	}
-	return value
+func MinSliceErr(values []T) (T, error) {
+	return imath.MinSliceErr(values)
 }
 
 func Max(value_0, value_1 T) T {
-	if value_0 > value_1 {
-		return value_0
-	}
-	return value_1
+	return imath.Max(value_0, value_1)
 }
 
 func Maxs(value T, values ...T) T {
-	for _, v := range values {
-		if v > value {
-			value = v
-		}
-	}
-	return value
+	return imath.Maxs(value, values...)
 }
 
 func MaxSlice(values []T) T {
-	return Maxs(values[0], values[1:]...)
+	return imath.MaxSlice(values)
 }
 
 func MaxSliceChecked(values []T) (T, bool) {
-	if len(values) == 0 {
-		return 0, true
-	}
-	return MaxSlice(values), false
+	return imath.MaxSliceChecked(values)
+}
+
+func MaxSliceErr(values []T) (T, error) {
+	return imath.MaxSliceErr(values)
 }
 
 func MinMax(value_0, value_1 T) (T, T) {
-	if value_0 < value_1 {
-		return value_0, value_1
-	}
-	return value_1, value_0
+	return imath.MinMax(value_0, value_1)
 }
 
 func MinMaxs(value T, values ...T) (T, T) {
-	min := value
-	max := value
-	for _, v := range values {
-		if v < min {
-			min = v
-		} else if v > max {
-			max = v
-		}
-	}
-	return min, max
+	return imath.MinMaxs(value, values...)
 }
 
 func MinMaxSlice(values []T) (T, T) {
-	return MinMaxs(values[0], values[1:]...)
+	return imath.MinMaxSlice(values)
 }
 
 func MinMaxSliceChecked(values []T) (T, T, bool) {
-	if len(values) == 0 {
-		return 0, 0, true
-	}
-	min, max := MinMaxSlice(values)
-	return min, max, false
+	return imath.MinMaxSliceChecked(values)
+}
+
+func MinMaxSliceErr(values []T) (T, T, error) {
+	return imath.MinMaxSliceErr(values)
+}
+
+func ModInverse(value, modulus T) (T, bool) {
+	return imath.ModInverse(value, modulus)
+}
+
+func MulSat(value_0, value_1 T) T {
+	return imath.MulSat(value_0, value_1)
+}
+
+func NegSat(value T) T {
+	return imath.NegSat(value)
 }
 
 func Sign(value T) T {
-	shift := BitSize - 1
-	return value >> shift | T((-ST(value &^ (1 << shift)) >> shift) & 1)
+	return imath.Sign(value)
+}
+
+func SubSat(minuend, subtrahend T) T {
+	return imath.SubSat(minuend, subtrahend)
 }
@@ -15,6 +15,8 @@ language governing permissions and limitations under the License.
 */
 package u64
 
+import "github.com/adam-lavrik/go-imath"
+
 // Fibonacci(index) returns Fibonacci sequence member with corresponding index:
 // - Fibonacci(0) == 0
 // - Fibonacci(1) == Fibonacci(2) == 1
@@ -23,13 +25,11 @@ package u64
 // ...
 // Result is calculated via matrix ((1, 1), (1, 0)) fast raising to `index` power.
 func Fibonacci(index uint) T {
-	v0, v1 := T(0), T(1) // Result vector
+	return imath.Fibonacci[T](index)
+}
 
-	for m00, m01, m10, m11 := v1, v1, v1, v0; index > 0; index >>= 1 { // `index` fast division by 2
-		if (index & 1) != 0 { // If index is odd
-			v0, v1 = v0 * m00 + v1 * m10, v0 * m01 + v1 * m11 // If power is odd then multiply result vector by matrix
-		}
-		m00, m01, m10, m11 = m00 * m00 + m01 * m10, m00 * m01 + m01 * m11, m10 * m00 + m11 * m10, m10 * m01 + m11 * m11 // Square the matrix
-	}
-	return v0
+// FibonacciChecked(index) returns Fibonacci sequence member like Fibonacci, but detects overflow.
+// Second result is `true` if the member fits into the type, otherwise it is `false`.
+func FibonacciChecked(index uint) (T, bool) {
+	return imath.FibonacciChecked[T](index)
 }
@@ -15,6 +15,8 @@ language governing permissions and limitations under the License.
 */
 package ux
 
+import "github.com/adam-lavrik/go-imath"
+
 // Fibonacci(index) returns Fibonacci sequence member with corresponding index:
 // - Fibonacci(0) == 0
 // - Fibonacci(1) == Fibonacci(2) == 1
@@ -23,13 +25,11 @@ package ux
 // ...
 // Result is calculated via matrix ((1, 1), (1, 0)) fast raising to `index` power.
 func Fibonacci(index uint) uint {
-	v0, v1 := uint(0), uint(1) // Result vector
+	return imath.Fibonacci[uint](index)
+}
 
-	for m00, m01, m10, m11 := v1, v1, v1, v0; index > 0; index >>= 1 { // `index` fast division by 2
-		if IsOdd(index) {
-			v0, v1 = v0 * m00 + v1 * m10, v0 * m01 + v1 * m11 // If power is odd then multiply result vector by matrix
-		}
-		m00, m01, m10, m11 = m00 * m00 + m01 * m10, m00 * m01 + m01 * m11, m10 * m00 + m11 * m10, m10 * m01 + m11 * m11 // Square the matrix
-	}
-	return v0
+// FibonacciChecked(index) returns Fibonacci sequence member like Fibonacci, but detects overflow.
+// Second result is `true` if the member fits into the type, otherwise it is `false`.
+func FibonacciChecked(index uint) (uint, bool) {
+	return imath.FibonacciChecked[uint](index)
 }
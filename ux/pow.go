@@ -15,17 +15,38 @@ limitations under the License.
 */
 package ux
 
+import "github.com/adam-lavrik/go-imath"
+
 // Pow raises `base` to `exponent` power.
 // Fast binary algorithm is used.
 // Pow(0, 0) == 1
 func Pow(base uint, exponent uint) uint {
-	power := uint(1)
-	for exponent > 0 {
-		if IsOdd(exponent) {
-			power *= base
-		}
-		base *= base
-		exponent >>= 1 // `exponent` fast division by 2
-	}
-	return power
+	return imath.Pow(base, exponent)
+}
+
+// PowChecked raises `base` to `exponent` power like Pow, but detects overflow.
+// Second result is `true` if the power fits into the type, otherwise it is `false`.
+func PowChecked(base uint, exponent uint) (uint, bool) {
+	return imath.PowChecked(base, exponent)
+}
+
+// PowSat raises `base` to `exponent` power like Pow, but clamps the result to [Minimal, Maximal] on overflow.
+func PowSat(base uint, exponent uint) uint {
+	return imath.PowSat(base, exponent)
+}
+
+// PowErr raises `base` to `exponent` power like Pow, but reports ErrOverflow if the power does not fit into the type.
+func PowErr(base uint, exponent uint) (uint, error) {
+	return imath.PowErr(base, exponent)
+}
+
+// PowMod raises `base` to `exponent` power modulo `modulus` without intermediate overflow.
+// Zero `modulus` causes division by zero error.
+func PowMod(base uint, exponent uint64, modulus uint) uint {
+	return imath.PowMod(base, exponent, modulus)
+}
+
+// MulMod returns product of `value_0` and `value_1` modulo `modulus` without intermediate overflow.
+func MulMod(value_0, value_1, modulus uint) uint {
+	return imath.MulMod(value_0, value_1, modulus)
 }